@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"oppossome/serverpouch/pkg/client"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultEndpoint = "http://localhost:8080"
+
+// cliConfig is persisted to the config file so the endpoint and token don't
+// need passing to every command.
+type cliConfig struct {
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	Token    string `json:"token"    yaml:"token"`
+}
+
+// cliOptions holds the global flags.
+type cliOptions struct {
+	configPath string
+	endpoint   string
+	token      string
+	output     string
+}
+
+func (o *cliOptions) path() (string, error) {
+	if o.configPath != "" {
+		return o.configPath, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find config directory")
+	}
+
+	return filepath.Join(configDir, "serverpouch", "config.yaml"), nil
+}
+
+// readConfig reads the config file, which may not exist yet.
+func (o *cliOptions) readConfig() (*cliConfig, error) {
+	path, err := o.path()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &cliConfig{}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return cfg, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to read config")
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config %s", path)
+	}
+
+	return cfg, nil
+}
+
+func (o *cliOptions) writeConfig(cfg *cliConfig) error {
+	path, err := o.path()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode config")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errors.Wrap(err, "failed to create config directory")
+	}
+
+	// The config holds the token, so keep it private.
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write config")
+	}
+
+	return nil
+}
+
+// resolveConfig layers the flags and environment over the config file.
+func (o *cliOptions) resolveConfig() (*cliConfig, error) {
+	cfg, err := o.readConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, override := range []struct {
+		value  *string
+		flag   string
+		envVar string
+	}{
+		{&cfg.Endpoint, o.endpoint, "SERVERPOUCH_ENDPOINT"},
+		{&cfg.Token, o.token, "SERVERPOUCH_TOKEN"},
+	} {
+		if env, ok := os.LookupEnv(override.envVar); ok && env != "" {
+			*override.value = env
+		}
+
+		if override.flag != "" {
+			*override.value = override.flag
+		}
+	}
+
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = defaultEndpoint
+	}
+
+	return cfg, nil
+}
+
+// newClient creates an API client from the resolved config.
+func (o *cliOptions) newClient() (*client.ClientWithResponses, *cliConfig, error) {
+	cfg, err := o.resolveConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cfg.Token == "" {
+		return nil, nil, errors.New("no API token configured, run \"serverpouch config set token <token>\"")
+	}
+
+	apiClient, err := client.NewClientWithResponses(cfg.Endpoint, client.WithToken(cfg.Token))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create client")
+	}
+
+	return apiClient, cfg, nil
+}
+
+// MARK: config
+
+func newConfigCommand(opts *cliOptions) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the CLI's config file",
+	}
+
+	configCmd.AddCommand(
+		&cobra.Command{
+			Use:       "set <endpoint|token> <value>",
+			Short:     "Set a config value",
+			Args:      cobra.ExactArgs(2),
+			ValidArgs: []string{"endpoint", "token"},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				cfg, err := opts.readConfig()
+				if err != nil {
+					return err
+				}
+
+				switch args[0] {
+				case "endpoint":
+					cfg.Endpoint = args[1]
+				case "token":
+					cfg.Token = args[1]
+				default:
+					return fmt.Errorf("unknown config key %q", args[0])
+				}
+
+				return opts.writeConfig(cfg)
+			},
+		},
+		&cobra.Command{
+			Use:   "view",
+			Short: "Show the resolved config, with the token redacted",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				cfg, err := opts.resolveConfig()
+				if err != nil {
+					return err
+				}
+
+				if cfg.Token != "" {
+					cfg.Token = "<redacted>"
+				}
+
+				return printOutput(cmd.OutOrStdout(), opts.output, cfg, func(t *table) {
+					t.row("ENDPOINT", "TOKEN")
+					t.row(cfg.Endpoint, cfg.Token)
+				})
+			},
+		},
+	)
+
+	return configCmd
+}
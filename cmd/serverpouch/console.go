@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"oppossome/serverpouch/pkg/client"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// MARK: console
+
+func newServerConsoleCommand(opts *cliOptions) *cobra.Command {
+	var history bool
+
+	cmd := &cobra.Command{
+		Use:   "console <id>",
+		Short: "Attach to a server's console, sending each line of input to it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseServerID(args[0])
+			if err != nil {
+				return err
+			}
+
+			conn, err := dialConsole(opts, id)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			if history {
+				if err := printConsoleHistory(cmd, opts, id); err != nil {
+					return err
+				}
+			}
+
+			go func() {
+				scanner := bufio.NewScanner(cmd.InOrStdin())
+				for scanner.Scan() {
+					if err := conn.WriteMessage(websocket.TextMessage, scanner.Bytes()); err != nil {
+						return
+					}
+				}
+
+				// Hang up once the input ends, so piped commands can finish.
+				msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+				conn.WriteMessage(websocket.CloseMessage, msg)
+			}()
+
+			return copyConsoleOutput(cmd.OutOrStdout(), conn)
+		},
+	}
+
+	cmd.Flags().BoolVar(&history, "history", true, "print the server's recent output first")
+
+	return cmd
+}
+
+// printConsoleHistory prints the server's recent output. It's fetched after
+// connecting to the console, so nothing printed in between is missed.
+func printConsoleHistory(cmd *cobra.Command, opts *cliOptions, id uuid.UUID) error {
+	apiClient, _, err := opts.newClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := apiClient.GetConsoleHistoryWithResponse(cmd.Context(), id, &client.GetConsoleHistoryParams{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get console history")
+	}
+
+	if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	for _, line := range resp.JSON200.Lines {
+		fmt.Fprintln(cmd.OutOrStdout(), line.Text)
+	}
+
+	return nil
+}
+
+// dialConsole opens the server's console WebSocket.
+func dialConsole(opts *cliOptions, id uuid.UUID) (*websocket.Conn, error) {
+	cfg, err := opts.resolveConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	consoleURL, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse endpoint")
+	}
+
+	switch consoleURL.Scheme {
+	case "https":
+		consoleURL.Scheme = "wss"
+	default:
+		consoleURL.Scheme = "ws"
+	}
+	consoleURL = consoleURL.JoinPath("api", "servers", id.String(), "console")
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+cfg.Token)
+
+	conn, resp, err := websocket.DefaultDialer.Dial(consoleURL.String(), header)
+	if err != nil {
+		// Surface the API's problem when the upgrade was refused.
+		if resp != nil {
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+			if problem := client.CheckResponse(resp, body); problem != nil {
+				return nil, problem
+			}
+		}
+
+		return nil, errors.Wrap(err, "failed to connect to console")
+	}
+
+	return conn, nil
+}
+
+// copyConsoleOutput writes every line the console sends until it closes.
+func copyConsoleOutput(w io.Writer, conn *websocket.Conn) error {
+	for {
+		_, msg, err := conn.ReadMessage()
+		switch {
+		case websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway):
+			return nil
+		case err != nil:
+			return errors.Wrap(err, "console disconnected")
+		}
+
+		fmt.Fprintln(w, string(msg))
+	}
+}
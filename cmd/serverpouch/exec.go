@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"oppossome/serverpouch/pkg/client"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// exitCodeError exits the CLI with the exit code of a command it ran.
+type exitCodeError struct {
+	code int
+}
+
+func (e *exitCodeError) Error() string {
+	return fmt.Sprintf("command exited with code %d", e.code)
+}
+
+func newServerExecCommand(opts *cliOptions) *cobra.Command {
+	var env []string
+	var workingDir, user string
+
+	cmd := &cobra.Command{
+		Use:     "exec <id> -- <command> [args...]",
+		Short:   "Run a command within a server, exiting with its exit code",
+		Example: "  serverpouch server exec <id> -- sh -c 'pg_dump app > /data/app.sql'",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseServerID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, _, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			newExec := client.NewExec{Command: args[1:]}
+			if len(env) > 0 {
+				newExec.Environment = &env
+			}
+			if workingDir != "" {
+				newExec.WorkingDir = &workingDir
+			}
+			if user != "" {
+				newExec.User = &user
+			}
+
+			resp, err := apiClient.StreamExecCommand(cmd.Context(), id, newExec)
+			if err != nil {
+				return errors.Wrap(err, "failed to run command")
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				body, _ := io.ReadAll(resp.Body)
+				return client.CheckResponse(resp, body)
+			}
+
+			return printExec(cmd, resp.Body)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&env, "env", "e", nil, "environment variable, as KEY=value")
+	cmd.Flags().StringVarP(&workingDir, "workdir", "w", "", "directory to run the command in")
+	cmd.Flags().StringVarP(&user, "user", "u", "", "user or user:group to run the command as")
+	// Anything after the ID belongs to the command, flags included.
+	cmd.Flags().SetInterspersed(false)
+
+	return cmd
+}
+
+// printExec prints each line of the command's output to the stream it came
+// from, returning an exitCodeError if it didn't succeed.
+func printExec(cmd *cobra.Command, body io.Reader) error {
+	decoder := json.NewDecoder(body)
+	for {
+		var line client.ExecStreamLine
+		err := decoder.Decode(&line)
+		switch {
+		case err == io.EOF:
+			return errors.New("connection closed before the command exited")
+		case err != nil:
+			return errors.Wrap(err, "failed to read command output")
+		}
+
+		if line.ExitCode != nil {
+			if *line.ExitCode != 0 {
+				return &exitCodeError{code: *line.ExitCode}
+			}
+			return nil
+		}
+
+		w := cmd.OutOrStdout()
+		if line.Stream != nil && *line.Stream == client.LogStreamStderr {
+			w = cmd.ErrOrStderr()
+		}
+
+		if line.Text != nil {
+			fmt.Fprintln(w, *line.Text)
+		}
+	}
+}
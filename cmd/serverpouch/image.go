@@ -0,0 +1,77 @@
+package main
+
+import (
+	"io"
+
+	"oppossome/serverpouch/pkg/client"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newServerUpdateImageCommand(opts *cliOptions) *cobra.Command {
+	var check bool
+
+	cmd := &cobra.Command{
+		Use:   "update-image <id>",
+		Short: "Pull a server's image and recreate the server if it changed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseServerID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, _, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			if check {
+				resp, err := apiClient.CheckServerImageWithResponse(cmd.Context(), id)
+				if err != nil {
+					return errors.Wrap(err, "failed to check server image")
+				}
+				if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+					return err
+				}
+
+				return printImageUpdate(cmd.OutOrStdout(), opts.output, resp.JSON200.Update)
+			}
+
+			resp, err := apiClient.UpdateServerImageWithResponse(cmd.Context(), id)
+			if err != nil {
+				return errors.Wrap(err, "failed to update server image")
+			}
+			if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+				return err
+			}
+
+			return printImageUpdate(cmd.OutOrStdout(), opts.output, resp.JSON200.Update)
+		},
+	}
+
+	cmd.Flags().BoolVar(&check, "check", false, "only report whether a newer image is available")
+
+	return cmd
+}
+
+func printImageUpdate(w io.Writer, format string, update client.ImageUpdate) error {
+	return printOutput(w, format, update, func(t *table) {
+		current := "-"
+		if update.CurrentDigest != nil {
+			current = *update.CurrentDigest
+		}
+
+		state := "up to date"
+		switch {
+		case update.Applied:
+			state = "updated"
+		case update.Available:
+			state = "update available"
+		}
+
+		t.row("IMAGE", "CURRENT", "LATEST", "STATE")
+		t.row(update.Image, current, update.LatestDigest, state)
+	})
+}
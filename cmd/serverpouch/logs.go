@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"oppossome/serverpouch/pkg/client"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newServerLogsCommand(opts *cliOptions) *cobra.Command {
+	var since, until, download string
+	var tail int
+	var timestamps, follow bool
+
+	cmd := &cobra.Command{
+		Use:   "logs <id>",
+		Short: "Print a server's logs, writing its stderr to stderr",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseServerID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, _, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			downloading := download != ""
+			params := &client.GetServerLogsParams{
+				Timestamps: &timestamps,
+				Follow:     &follow,
+				Download:   &downloading,
+			}
+			if since != "" {
+				params.Since = &since
+			}
+			if until != "" {
+				params.Until = &until
+			}
+			if cmd.Flags().Changed("tail") {
+				params.Tail = &tail
+			}
+
+			resp, err := apiClient.GetServerLogs(cmd.Context(), id, params)
+			if err != nil {
+				return errors.Wrap(err, "failed to get server logs")
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				body, _ := io.ReadAll(resp.Body)
+				return client.CheckResponse(resp, body)
+			}
+
+			if downloading {
+				return downloadLogs(cmd, download, resp.Body)
+			}
+
+			return printLogs(cmd, resp.Body, timestamps)
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "", "only show lines after an RFC 3339 time, or a duration ago such as 10m")
+	cmd.Flags().StringVar(&until, "until", "", "only show lines before an RFC 3339 time, or a duration ago such as 10m")
+	cmd.Flags().IntVarP(&tail, "tail", "n", 0, "only show this many of the most recent lines")
+	cmd.Flags().BoolVarP(&timestamps, "timestamps", "t", false, "prefix each line with the time it was printed")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep printing lines until interrupted")
+	cmd.Flags().StringVar(&download, "download", "", "save the logs to a gzipped file, or - for stdout")
+
+	return cmd
+}
+
+// printLogs prints each line of the NDJSON logs to the stream it came from.
+func printLogs(cmd *cobra.Command, body io.Reader, timestamps bool) error {
+	decoder := json.NewDecoder(body)
+	for {
+		var line client.LogLine
+		err := decoder.Decode(&line)
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return errors.Wrap(err, "failed to read logs")
+		}
+
+		w := cmd.OutOrStdout()
+		if line.Stream == client.LogStreamStderr {
+			w = cmd.ErrOrStderr()
+		}
+
+		if timestamps && line.Timestamp != nil {
+			fmt.Fprintf(w, "%s %s\n", line.Timestamp.Format(time.RFC3339Nano), line.Text)
+		} else {
+			fmt.Fprintln(w, line.Text)
+		}
+	}
+}
+
+func downloadLogs(cmd *cobra.Command, path string, body io.Reader) error {
+	if path == "-" {
+		_, err := io.Copy(cmd.OutOrStdout(), body)
+		return errors.Wrap(err, "failed to download logs")
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, body); err != nil {
+		return errors.Wrap(err, "failed to download logs")
+	}
+
+	return file.Close()
+}
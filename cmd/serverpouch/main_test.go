@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"oppossome/serverpouch/pkg/client"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// runCLI runs the CLI against the handler, returning its output.
+func runCLI(t *testing.T, handler http.HandlerFunc, args ...string) (string, error) {
+	testServer := httptest.NewServer(handler)
+	t.Cleanup(testServer.Close)
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(configPath, []byte("endpoint: "+testServer.URL+"\ntoken: sp_test\n"), 0o600)
+	assert.NoError(t, err)
+
+	var out bytes.Buffer
+	rootCmd := newRootCommand()
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs(append([]string{"--config", configPath}, args...))
+
+	err = rootCmd.Execute()
+	return out.String(), err
+}
+
+func TestServerList(t *testing.T) {
+	id := uuid.New()
+	srvCfg := client.ServerConfig{}
+	assert.NoError(t, srvCfg.FromServerConfigDocker(client.ServerConfigDocker{
+		Type:  client.ServerConfigDockerTypeDocker,
+		Image: "itzg/minecraft-server",
+	}))
+	labels := map[string]string{"game": "minecraft"}
+	srv := client.Server{Id: id, Name: "survival", Status: client.Idle, Config: srvCfg, Labels: &labels}
+
+	// Serve the servers over two pages to check the cursor is followed.
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer sp_test", r.Header.Get("Authorization"))
+		assert.Equal(t, []string{"game=minecraft"}, r.URL.Query()["label"])
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			next := "next"
+			json.NewEncoder(w).Encode(client.ServersResponse{Servers: []client.Server{srv}, NextCursor: &next})
+			return
+		}
+
+		json.NewEncoder(w).Encode(client.ServersResponse{Servers: []client.Server{srv}})
+	}
+
+	t.Run("Table", func(t *testing.T) {
+		out, err := runCLI(t, handler, "server", "list", "--label", "game=minecraft")
+		assert.NoError(t, err)
+
+		row := id.String() + "   survival   idle     itzg/minecraft-server   game=minecraft\n"
+		assert.Equal(t, "ID                                     NAME       STATUS   IMAGE                   LABELS\n"+row+row, out)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		out, err := runCLI(t, handler, "server", "list", "--label", "game=minecraft", "-o", "json")
+		assert.NoError(t, err)
+
+		var servers []client.Server
+		assert.NoError(t, json.Unmarshal([]byte(out), &servers))
+		assert.Len(t, servers, 2)
+		assert.Equal(t, "survival", servers[0].Name)
+	})
+}
+
+func TestServerStartProblem(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"type":"urn:serverpouch:problem:invalid-status","title":"Conflict","status":409,"detail":"start is an invalid action for status running"}`))
+	}
+
+	_, err := runCLI(t, handler, "server", "start", uuid.NewString())
+	assert.EqualError(t, err, "Conflict: start is an invalid action for status running")
+}
+
+func TestServerLogs(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10m", r.URL.Query().Get("since"))
+		assert.Equal(t, "5", r.URL.Query().Get("tail"))
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Write([]byte(`{"stream":"stdout","text":"Hello"}` + "\n" + `{"stream":"stderr","text":"Oops"}` + "\n"))
+	}
+
+	// Only stdout is captured, stderr's line goes to stderr.
+	out, err := runCLI(t, handler, "server", "logs", uuid.NewString(), "--since", "10m", "-n", "5")
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello\n", out)
+}
+
+func TestServerExec(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var newExec client.NewExec
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&newExec))
+		assert.Equal(t, []string{"sh", "-c", "exit 3"}, newExec.Command)
+		assert.Equal(t, &[]string{"LANG=C"}, newExec.Environment)
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Write([]byte(`{"stream":"stdout","text":"Hello"}` + "\n" + `{"exitCode":3}` + "\n"))
+	}
+
+	// Flags after the ID are passed along to the command.
+	out, err := runCLI(t, handler, "server", "exec", "-e", "LANG=C", uuid.NewString(), "sh", "-c", "exit 3")
+	assert.Equal(t, "Hello\n", out)
+
+	var exitErr *exitCodeError
+	assert.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 3, exitErr.code)
+}
+
+func TestServerUpdateImage(t *testing.T) {
+	current := "sha256:current"
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		// Checking only reads the update, without applying it.
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(client.ImageUpdateResponse{Update: client.ImageUpdate{
+			Image:         "itzg/minecraft-server",
+			CurrentDigest: &current,
+			LatestDigest:  "sha256:latest",
+			Available:     true,
+		}})
+	}
+
+	out, err := runCLI(t, handler, "server", "update-image", uuid.NewString(), "--check")
+	assert.NoError(t, err)
+	assert.Equal(t, "IMAGE                   CURRENT          LATEST          STATE\n"+
+		"itzg/minecraft-server   sha256:current   sha256:latest   update available\n", out)
+}
+
+func TestResolveConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	opts := &cliOptions{configPath: configPath}
+
+	cfg, err := opts.resolveConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, &cliConfig{Endpoint: defaultEndpoint}, cfg)
+
+	assert.NoError(t, opts.writeConfig(&cliConfig{Endpoint: "http://file", Token: "sp_file"}))
+
+	t.Setenv("SERVERPOUCH_TOKEN", "sp_env")
+	opts.endpoint = "http://flag"
+
+	cfg, err = opts.resolveConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, &cliConfig{Endpoint: "http://flag", Token: "sp_env"}, cfg)
+}
+
+func TestUnknownCommand(t *testing.T) {
+	// Arguments that aren't a command mustn't fall through to the daemon.
+	_, err := runCLI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}, "bogus")
+	assert.ErrorContains(t, err, "unknown command")
+}
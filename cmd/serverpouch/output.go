@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// table writes tab aligned columns.
+type table struct {
+	w *tabwriter.Writer
+}
+
+func (t *table) row(columns ...string) {
+	fmt.Fprintln(t.w, strings.Join(columns, "\t"))
+}
+
+// printOutput writes the value as JSON, or as the table built by fill.
+func printOutput(w io.Writer, format string, value any, fill func(*table)) error {
+	switch format {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+
+	case outputTable:
+		t := &table{w: tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)}
+		fill(t)
+		return t.w.Flush()
+
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"oppossome/serverpouch/pkg/client"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newServerCommand(opts *cliOptions) *cobra.Command {
+	serverCmd := &cobra.Command{
+		Use:     "server",
+		Aliases: []string{"servers"},
+		Short:   "Manage servers",
+	}
+
+	serverCmd.AddCommand(
+		newServerListCommand(opts),
+		newServerGetCommand(opts),
+		newServerCreateCommand(opts),
+		newServerActionCommand(opts, "start", "Start a server", client.ClientInterface.StartServer),
+		newServerActionCommand(opts, "stop", "Gracefully stop a server", client.ClientInterface.StopServer),
+		newServerActionCommand(opts, "kill", "Forcefully kill a server", client.ClientInterface.KillServer),
+		newServerUpdateImageCommand(opts),
+		newServerLogsCommand(opts),
+		newServerConsoleCommand(opts),
+		newServerExecCommand(opts),
+	)
+
+	return serverCmd
+}
+
+// MARK: list
+
+func newServerListCommand(opts *cliOptions) *cobra.Command {
+	var statuses, types, labels []string
+	var image, sort, order string
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List servers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, _, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			params := &client.ListServersParams{}
+			if len(statuses) > 0 {
+				params.Status = &[]client.ServerStatus{}
+				for _, status := range statuses {
+					*params.Status = append(*params.Status, client.ServerStatus(status))
+				}
+			}
+			if len(types) > 0 {
+				params.Type = &[]client.ListServersParamsType{}
+				for _, instType := range types {
+					*params.Type = append(*params.Type, client.ListServersParamsType(instType))
+				}
+			}
+			if len(labels) > 0 {
+				params.Label = &labels
+			}
+			if image != "" {
+				params.Image = &image
+			}
+			if sort != "" {
+				params.Sort = (*client.ListServersParamsSort)(&sort)
+			}
+			if order != "" {
+				params.Order = (*client.ListServersParamsOrder)(&order)
+			}
+
+			// Follow the cursor through every page.
+			servers := []client.Server{}
+			for {
+				resp, err := apiClient.ListServersWithResponse(cmd.Context(), params)
+				if err != nil {
+					return errors.Wrap(err, "failed to list servers")
+				}
+				if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+					return err
+				}
+
+				servers = append(servers, resp.JSON200.Servers...)
+				if resp.JSON200.NextCursor == nil {
+					break
+				}
+				params.Cursor = resp.JSON200.NextCursor
+			}
+
+			return printServers(cmd.OutOrStdout(), opts.output, servers)
+		},
+	}
+
+	listCmd.Flags().StringSliceVar(&statuses, "status", nil, "only list servers with any of these statuses")
+	listCmd.Flags().StringSliceVar(&types, "type", nil, "only list servers of any of these types")
+	listCmd.Flags().StringArrayVarP(&labels, "label", "l", nil, "only list servers with this label, as key=value or key")
+	listCmd.Flags().StringVar(&image, "image", "", "only list servers using this image")
+	listCmd.Flags().StringVar(&sort, "sort", "", "sort by createdAt or name")
+	listCmd.Flags().StringVar(&order, "order", "", "sort asc or desc")
+
+	return listCmd
+}
+
+// MARK: get
+
+func newServerGetCommand(opts *cliOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a server",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseServerID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, _, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := apiClient.GetServerWithResponse(cmd.Context(), id)
+			if err != nil {
+				return errors.Wrap(err, "failed to get server")
+			}
+			if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+				return err
+			}
+
+			return printServer(cmd.OutOrStdout(), opts.output, resp.JSON200.Server)
+		},
+	}
+}
+
+// MARK: create
+
+func newServerCreateCommand(opts *cliOptions) *cobra.Command {
+	var file, name, description, image string
+	var ports, volumes, env, labels []string
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a server from flags, or from a JSON file",
+		Example: "  serverpouch server create --name survival --image itzg/minecraft-server --port 25565:25565/tcp --env EULA=TRUE\n" +
+			"  serverpouch server create -f server.json",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var newServer client.NewServer
+			var err error
+			if file != "" {
+				newServer, err = readNewServer(cmd.InOrStdin(), file)
+			} else {
+				newServer, err = flagsToNewServer(name, description, image, ports, volumes, env, labels)
+			}
+			if err != nil {
+				return err
+			}
+
+			apiClient, _, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := apiClient.CreateServerWithResponse(cmd.Context(), newServer)
+			if err != nil {
+				return errors.Wrap(err, "failed to create server")
+			}
+			if err := client.CheckResponse(resp.HTTPResponse, resp.Body); err != nil {
+				return err
+			}
+
+			return printServer(cmd.OutOrStdout(), opts.output, resp.JSON201.Server)
+		},
+	}
+
+	createCmd.Flags().StringVarP(&file, "file", "f", "", "read the server from a JSON file, or - for stdin")
+	createCmd.Flags().StringVar(&name, "name", "", "name of the server")
+	createCmd.Flags().StringVar(&description, "description", "", "notes on what the server is for")
+	createCmd.Flags().StringVar(&image, "image", "", "Docker image to run")
+	createCmd.Flags().StringArrayVarP(&ports, "port", "p", nil, "port to publish, as hostPort:containerPort/protocol")
+	createCmd.Flags().StringArrayVarP(&volumes, "volume", "v", nil, "volume to mount, as hostPath:containerPath")
+	createCmd.Flags().StringArrayVarP(&env, "env", "e", nil, "environment variable, as KEY=value")
+	createCmd.Flags().StringArrayVarP(&labels, "label", "l", nil, "label, as key=value")
+	createCmd.MarkFlagsMutuallyExclusive("file", "name")
+	createCmd.MarkFlagsMutuallyExclusive("file", "description")
+	createCmd.MarkFlagsMutuallyExclusive("file", "image")
+
+	return createCmd
+}
+
+func readNewServer(stdin io.Reader, file string) (client.NewServer, error) {
+	var newServer client.NewServer
+
+	reader := stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return newServer, errors.Wrap(err, "failed to open server file")
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	if err := json.NewDecoder(reader).Decode(&newServer); err != nil {
+		return newServer, errors.Wrap(err, "failed to decode server file")
+	}
+
+	return newServer, nil
+}
+
+func flagsToNewServer(name, description, image string, ports, volumes, env, labels []string) (client.NewServer, error) {
+	var newServer client.NewServer
+
+	if name == "" || image == "" {
+		return newServer, errors.New("--name and --image are required without --file")
+	}
+
+	dockerCfg := client.ServerConfigDocker{
+		Type:        client.ServerConfigDockerTypeDocker,
+		Image:       image,
+		Ports:       append([]string{}, ports...),
+		Volumes:     append([]string{}, volumes...),
+		Environment: append([]string{}, env...),
+	}
+	if err := newServer.Config.FromServerConfigDocker(dockerCfg); err != nil {
+		return newServer, errors.Wrap(err, "failed to encode config")
+	}
+
+	newServer.Name = name
+	if description != "" {
+		newServer.Description = &description
+	}
+	if len(labels) > 0 {
+		labelMap := map[string]string{}
+		for _, label := range labels {
+			key, value, ok := strings.Cut(label, "=")
+			if !ok {
+				return newServer, fmt.Errorf("label %q must be of the form key=value", label)
+			}
+			labelMap[key] = value
+		}
+		newServer.Labels = &labelMap
+	}
+
+	return newServer, nil
+}
+
+// MARK: start, stop & kill
+
+// serverAction is one of the client's action requests, which share a signature.
+type serverAction func(client.ClientInterface, context.Context, client.ServerID, ...client.RequestEditorFn) (*http.Response, error)
+
+func newServerActionCommand(opts *cliOptions, use string, short string, action serverAction) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " <id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseServerID(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiClient, _, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := action(apiClient.ClientInterface, cmd.Context(), id)
+			if err != nil {
+				return errors.Wrapf(err, "failed to %s server", use)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return errors.Wrap(err, "failed to read response")
+			}
+			if err := client.CheckResponse(resp, body); err != nil {
+				return err
+			}
+
+			var serverResp client.ServerResponse
+			if err := json.Unmarshal(body, &serverResp); err != nil {
+				return errors.Wrap(err, "failed to decode response")
+			}
+
+			return printServer(cmd.OutOrStdout(), opts.output, serverResp.Server)
+		},
+	}
+}
+
+// MARK: helpers
+
+func parseServerID(arg string) (uuid.UUID, error) {
+	id, err := uuid.Parse(arg)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%q is not a server ID", arg)
+	}
+
+	return id, nil
+}
+
+func printServer(w io.Writer, format string, server client.Server) error {
+	return printOutput(w, format, server, func(t *table) {
+		serverTable(t, []client.Server{server})
+	})
+}
+
+func printServers(w io.Writer, format string, servers []client.Server) error {
+	return printOutput(w, format, servers, func(t *table) {
+		serverTable(t, servers)
+	})
+}
+
+func serverTable(t *table, servers []client.Server) {
+	t.row("ID", "NAME", "STATUS", "IMAGE", "LABELS")
+
+	for _, server := range servers {
+		image := ""
+		if dockerCfg, err := server.Config.AsServerConfigDocker(); err == nil {
+			image = dockerCfg.Image
+		}
+
+		labels := []string{}
+		if server.Labels != nil {
+			for _, key := range slices.Sorted(maps.Keys(*server.Labels)) {
+				labels = append(labels, key+"="+(*server.Labels)[key])
+			}
+		}
+
+		t.row(server.Id.String(), server.Name, string(server.Status), image, strings.Join(labels, ","))
+	}
+}
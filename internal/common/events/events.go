@@ -2,6 +2,10 @@ package events
 
 import (
 	"sync"
+
+	"oppossome/serverpouch/internal/common/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type EventEmitter[O any] interface {
@@ -14,6 +18,8 @@ type EventEmitter[O any] interface {
 type eventEmitterImpl[O any] struct {
 	mu        sync.RWMutex
 	listeners []chan O
+
+	listenerGauge prometheus.Gauge
 }
 
 var _ EventEmitter[any] = (*eventEmitterImpl[any])(nil)
@@ -24,11 +30,29 @@ func (e *eventEmitterImpl[O]) On() <-chan O {
 
 	listener := make(chan O)
 	e.listeners = append(e.listeners, listener)
+	e.listenerGauge.Inc()
 
 	return listener
 }
 
 func (e *eventEmitterImpl[O]) Off(listener <-chan O) {
+	// An in-flight Dispatch holds the lock until every listener has received
+	// its value, so keep draining ours until it's closed below, or turns out
+	// not to be ours to close.
+	notFound := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case _, ok := <-listener:
+				if !ok {
+					return
+				}
+			case <-notFound:
+				return
+			}
+		}
+	}()
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -38,9 +62,12 @@ func (e *eventEmitterImpl[O]) Off(listener <-chan O) {
 		}
 
 		e.listeners = append(e.listeners[:idx], e.listeners[idx+1:]...)
+		e.listenerGauge.Dec()
 		close(channel)
 		return
 	}
+
+	close(notFound)
 }
 
 func (e *eventEmitterImpl[O]) Dispatch(value O) {
@@ -67,11 +94,15 @@ func (e *eventEmitterImpl[O]) Close() {
 		close(channel)
 	}
 
+	e.listenerGauge.Sub(float64(len(e.listeners)))
 	e.listeners = []chan O{}
 }
 
-func New[O any]() *eventEmitterImpl[O] {
+// New creates an emitter, whose listeners are counted under the name.
+func New[O any](name string) *eventEmitterImpl[O] {
 	return &eventEmitterImpl[O]{
 		listeners: []chan O{},
+
+		listenerGauge: metrics.EventListeners.WithLabelValues(name),
 	}
 }
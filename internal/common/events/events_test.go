@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"oppossome/serverpouch/internal/common/events"
+	"oppossome/serverpouch/internal/common/metrics"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,7 +26,7 @@ func channelGet[O any](t *testing.T, channel <-chan O) *O {
 
 func TestEvents(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
-		testEvent := events.New[int]()
+		testEvent := events.New[int]("test")
 		chan1 := testEvent.On()
 		chan2 := testEvent.On()
 
@@ -44,4 +46,40 @@ func TestEvents(t *testing.T) {
 		assert.Nil(t, channelGet(t, chan1))
 		assert.Nil(t, channelGet(t, chan2))
 	})
+
+	t.Run("Ok - Off during a pending dispatch", func(t *testing.T) {
+		testEvent := events.New[int]("test")
+		chan1 := testEvent.On()
+
+		// Nobody reads chan1, so this dispatch stays blocked until it's removed.
+		dispatched := make(chan struct{})
+		go func() {
+			testEvent.Dispatch(1)
+			close(dispatched)
+		}()
+
+		time.Sleep(time.Millisecond * 100)
+		testEvent.Off(chan1)
+
+		select {
+		case <-dispatched:
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "Dispatch never completed.")
+		}
+	})
+
+	t.Run("Ok - Listeners are counted", func(t *testing.T) {
+		testEvent := events.New[int]("test_listeners")
+		listeners := metrics.EventListeners.WithLabelValues("test_listeners")
+
+		chan1 := testEvent.On()
+		testEvent.On()
+		assert.Equal(t, float64(2), testutil.ToFloat64(listeners))
+
+		testEvent.Off(chan1)
+		assert.Equal(t, float64(1), testutil.ToFloat64(listeners))
+
+		testEvent.Close()
+		assert.Equal(t, float64(0), testutil.ToFloat64(listeners))
+	})
 }
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "serverpouch"
+
+// Every metric is registered with the default registry, alongside the Go
+// runtime and process metrics it already collects.
+var (
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "API requests handled, by operationId and status code.",
+	}, []string{"operation", "code"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle API requests, by operationId.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	Instances = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "instances",
+		Help:      "Server instances, by status.",
+	}, []string{"status"})
+
+	LifecycleActionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "lifecycle",
+		Name:      "action_duration_seconds",
+		Help:      "Time taken by lifecycle actions, including waiting for the previous action.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"action"})
+
+	LifecycleActionFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "lifecycle",
+		Name:      "action_failures_total",
+		Help:      "Lifecycle actions which failed.",
+	}, []string{"action"})
+
+	ImagePullDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "docker",
+		Name:      "image_pull_duration_seconds",
+		Help:      "Time taken to pull images.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	})
+
+	EventListeners = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "listeners",
+		Help:      "Listeners subscribed to event emitters, by emitter.",
+	}, []string{"emitter"})
+)
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
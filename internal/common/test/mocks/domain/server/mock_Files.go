@@ -0,0 +1,385 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package server
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	server "oppossome/serverpouch/internal/domain/server"
+)
+
+// MockFiles is an autogenerated mock type for the Files type
+type MockFiles struct {
+	mock.Mock
+}
+
+type MockFiles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFiles) EXPECT() *MockFiles_Expecter {
+	return &MockFiles_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, path
+func (_m *MockFiles) List(ctx context.Context, path string) ([]server.FileInfo, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []server.FileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]server.FileInfo, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []server.FileInfo); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]server.FileInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFiles_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockFiles_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockFiles_Expecter) List(ctx interface{}, path interface{}) *MockFiles_List_Call {
+	return &MockFiles_List_Call{Call: _e.mock.On("List", ctx, path)}
+}
+
+func (_c *MockFiles_List_Call) Run(run func(ctx context.Context, path string)) *MockFiles_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFiles_List_Call) Return(_a0 []server.FileInfo, _a1 error) *MockFiles_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFiles_List_Call) RunAndReturn(run func(context.Context, string) ([]server.FileInfo, error)) *MockFiles_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mkdir provides a mock function with given fields: ctx, path
+func (_m *MockFiles) Mkdir(ctx context.Context, path string) (server.FileInfo, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mkdir")
+	}
+
+	var r0 server.FileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (server.FileInfo, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) server.FileInfo); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Get(0).(server.FileInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFiles_Mkdir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mkdir'
+type MockFiles_Mkdir_Call struct {
+	*mock.Call
+}
+
+// Mkdir is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockFiles_Expecter) Mkdir(ctx interface{}, path interface{}) *MockFiles_Mkdir_Call {
+	return &MockFiles_Mkdir_Call{Call: _e.mock.On("Mkdir", ctx, path)}
+}
+
+func (_c *MockFiles_Mkdir_Call) Run(run func(ctx context.Context, path string)) *MockFiles_Mkdir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFiles_Mkdir_Call) Return(_a0 server.FileInfo, _a1 error) *MockFiles_Mkdir_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFiles_Mkdir_Call) RunAndReturn(run func(context.Context, string) (server.FileInfo, error)) *MockFiles_Mkdir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx, path
+func (_m *MockFiles) Open(ctx context.Context, path string) (io.ReadCloser, server.FileInfo, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 io.ReadCloser
+	var r1 server.FileInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, server.FileInfo, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) server.FileInfo); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Get(1).(server.FileInfo)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, path)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockFiles_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockFiles_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockFiles_Expecter) Open(ctx interface{}, path interface{}) *MockFiles_Open_Call {
+	return &MockFiles_Open_Call{Call: _e.mock.On("Open", ctx, path)}
+}
+
+func (_c *MockFiles_Open_Call) Run(run func(ctx context.Context, path string)) *MockFiles_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFiles_Open_Call) Return(_a0 io.ReadCloser, _a1 server.FileInfo, _a2 error) *MockFiles_Open_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockFiles_Open_Call) RunAndReturn(run func(context.Context, string) (io.ReadCloser, server.FileInfo, error)) *MockFiles_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, path, recursive
+func (_m *MockFiles) Remove(ctx context.Context, path string, recursive bool) error {
+	ret := _m.Called(ctx, path, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, path, recursive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFiles_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockFiles_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - recursive bool
+func (_e *MockFiles_Expecter) Remove(ctx interface{}, path interface{}, recursive interface{}) *MockFiles_Remove_Call {
+	return &MockFiles_Remove_Call{Call: _e.mock.On("Remove", ctx, path, recursive)}
+}
+
+func (_c *MockFiles_Remove_Call) Run(run func(ctx context.Context, path string, recursive bool)) *MockFiles_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockFiles_Remove_Call) Return(_a0 error) *MockFiles_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFiles_Remove_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockFiles_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rename provides a mock function with given fields: ctx, from, to
+func (_m *MockFiles) Rename(ctx context.Context, from string, to string) (server.FileInfo, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 server.FileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (server.FileInfo, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) server.FileInfo); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(server.FileInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFiles_Rename_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rename'
+type MockFiles_Rename_Call struct {
+	*mock.Call
+}
+
+// Rename is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from string
+//   - to string
+func (_e *MockFiles_Expecter) Rename(ctx interface{}, from interface{}, to interface{}) *MockFiles_Rename_Call {
+	return &MockFiles_Rename_Call{Call: _e.mock.On("Rename", ctx, from, to)}
+}
+
+func (_c *MockFiles_Rename_Call) Run(run func(ctx context.Context, from string, to string)) *MockFiles_Rename_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFiles_Rename_Call) Return(_a0 server.FileInfo, _a1 error) *MockFiles_Rename_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFiles_Rename_Call) RunAndReturn(run func(context.Context, string, string) (server.FileInfo, error)) *MockFiles_Rename_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: ctx, path, contents
+func (_m *MockFiles) Write(ctx context.Context, path string, contents io.Reader) (server.FileInfo, error) {
+	ret := _m.Called(ctx, path, contents)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Write")
+	}
+
+	var r0 server.FileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) (server.FileInfo, error)); ok {
+		return rf(ctx, path, contents)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) server.FileInfo); ok {
+		r0 = rf(ctx, path, contents)
+	} else {
+		r0 = ret.Get(0).(server.FileInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader) error); ok {
+		r1 = rf(ctx, path, contents)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFiles_Write_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Write'
+type MockFiles_Write_Call struct {
+	*mock.Call
+}
+
+// Write is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - contents io.Reader
+func (_e *MockFiles_Expecter) Write(ctx interface{}, path interface{}, contents interface{}) *MockFiles_Write_Call {
+	return &MockFiles_Write_Call{Call: _e.mock.On("Write", ctx, path, contents)}
+}
+
+func (_c *MockFiles_Write_Call) Run(run func(ctx context.Context, path string, contents io.Reader)) *MockFiles_Write_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockFiles_Write_Call) Return(_a0 server.FileInfo, _a1 error) *MockFiles_Write_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFiles_Write_Call) RunAndReturn(run func(context.Context, string, io.Reader) (server.FileInfo, error)) *MockFiles_Write_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFiles creates a new instance of MockFiles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFiles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFiles {
+	mock := &MockFiles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
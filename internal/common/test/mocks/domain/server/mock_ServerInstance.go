@@ -3,6 +3,7 @@
 package server
 
 import (
+	context "context"
 	server "oppossome/serverpouch/internal/domain/server"
 
 	mock "github.com/stretchr/testify/mock"
@@ -21,6 +22,64 @@ func (_m *MockServerInstance) EXPECT() *MockServerInstance_Expecter {
 	return &MockServerInstance_Expecter{mock: &_m.Mock}
 }
 
+// CheckImage provides a mock function with given fields: _a0
+func (_m *MockServerInstance) CheckImage(_a0 context.Context) (*server.ImageUpdate, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckImage")
+	}
+
+	var r0 *server.ImageUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*server.ImageUpdate, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *server.ImageUpdate); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*server.ImageUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServerInstance_CheckImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckImage'
+type MockServerInstance_CheckImage_Call struct {
+	*mock.Call
+}
+
+// CheckImage is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockServerInstance_Expecter) CheckImage(_a0 interface{}) *MockServerInstance_CheckImage_Call {
+	return &MockServerInstance_CheckImage_Call{Call: _e.mock.On("CheckImage", _a0)}
+}
+
+func (_c *MockServerInstance_CheckImage_Call) Run(run func(_a0 context.Context)) *MockServerInstance_CheckImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_CheckImage_Call) Return(_a0 *server.ImageUpdate, _a1 error) *MockServerInstance_CheckImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServerInstance_CheckImage_Call) RunAndReturn(run func(context.Context) (*server.ImageUpdate, error)) *MockServerInstance_CheckImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockServerInstance) Close() {
 	_m.Called()
@@ -100,6 +159,51 @@ func (_c *MockServerInstance_Config_Call) RunAndReturn(run func() server.ServerI
 	return _c
 }
 
+// Crashes provides a mock function with no fields
+func (_m *MockServerInstance) Crashes() server.CrashState {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Crashes")
+	}
+
+	var r0 server.CrashState
+	if rf, ok := ret.Get(0).(func() server.CrashState); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(server.CrashState)
+	}
+
+	return r0
+}
+
+// MockServerInstance_Crashes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Crashes'
+type MockServerInstance_Crashes_Call struct {
+	*mock.Call
+}
+
+// Crashes is a helper method to define mock.On call
+func (_e *MockServerInstance_Expecter) Crashes() *MockServerInstance_Crashes_Call {
+	return &MockServerInstance_Crashes_Call{Call: _e.mock.On("Crashes")}
+}
+
+func (_c *MockServerInstance_Crashes_Call) Run(run func()) *MockServerInstance_Crashes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Crashes_Call) Return(_a0 server.CrashState) *MockServerInstance_Crashes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServerInstance_Crashes_Call) RunAndReturn(run func() server.CrashState) *MockServerInstance_Crashes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Events provides a mock function with no fields
 func (_m *MockServerInstance) Events() *server.ServerInstanceEvents {
 	ret := _m.Called()
@@ -147,6 +251,112 @@ func (_c *MockServerInstance_Events_Call) RunAndReturn(run func() *server.Server
 	return _c
 }
 
+// Exec provides a mock function with given fields: _a0, _a1
+func (_m *MockServerInstance) Exec(_a0 context.Context, _a1 server.ExecOptions) (server.ExecProcess, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 server.ExecProcess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, server.ExecOptions) (server.ExecProcess, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, server.ExecOptions) server.ExecProcess); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ExecProcess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, server.ExecOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServerInstance_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockServerInstance_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 server.ExecOptions
+func (_e *MockServerInstance_Expecter) Exec(_a0 interface{}, _a1 interface{}) *MockServerInstance_Exec_Call {
+	return &MockServerInstance_Exec_Call{Call: _e.mock.On("Exec", _a0, _a1)}
+}
+
+func (_c *MockServerInstance_Exec_Call) Run(run func(_a0 context.Context, _a1 server.ExecOptions)) *MockServerInstance_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(server.ExecOptions))
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Exec_Call) Return(_a0 server.ExecProcess, _a1 error) *MockServerInstance_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServerInstance_Exec_Call) RunAndReturn(run func(context.Context, server.ExecOptions) (server.ExecProcess, error)) *MockServerInstance_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Files provides a mock function with no fields
+func (_m *MockServerInstance) Files() server.Files {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Files")
+	}
+
+	var r0 server.Files
+	if rf, ok := ret.Get(0).(func() server.Files); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.Files)
+		}
+	}
+
+	return r0
+}
+
+// MockServerInstance_Files_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Files'
+type MockServerInstance_Files_Call struct {
+	*mock.Call
+}
+
+// Files is a helper method to define mock.On call
+func (_e *MockServerInstance_Expecter) Files() *MockServerInstance_Files_Call {
+	return &MockServerInstance_Files_Call{Call: _e.mock.On("Files")}
+}
+
+func (_c *MockServerInstance_Files_Call) Run(run func()) *MockServerInstance_Files_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Files_Call) Return(_a0 server.Files) *MockServerInstance_Files_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServerInstance_Files_Call) RunAndReturn(run func() server.Files) *MockServerInstance_Files_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Kill provides a mock function with no fields
 func (_m *MockServerInstance) Kill() error {
 	ret := _m.Called()
@@ -192,6 +402,156 @@ func (_c *MockServerInstance_Kill_Call) RunAndReturn(run func() error) *MockServ
 	return _c
 }
 
+// Logs provides a mock function with given fields: _a0, _a1
+func (_m *MockServerInstance) Logs(_a0 context.Context, _a1 server.LogOptions) (server.LogReader, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logs")
+	}
+
+	var r0 server.LogReader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, server.LogOptions) (server.LogReader, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, server.LogOptions) server.LogReader); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.LogReader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, server.LogOptions) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServerInstance_Logs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logs'
+type MockServerInstance_Logs_Call struct {
+	*mock.Call
+}
+
+// Logs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 server.LogOptions
+func (_e *MockServerInstance_Expecter) Logs(_a0 interface{}, _a1 interface{}) *MockServerInstance_Logs_Call {
+	return &MockServerInstance_Logs_Call{Call: _e.mock.On("Logs", _a0, _a1)}
+}
+
+func (_c *MockServerInstance_Logs_Call) Run(run func(_a0 context.Context, _a1 server.LogOptions)) *MockServerInstance_Logs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(server.LogOptions))
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Logs_Call) Return(_a0 server.LogReader, _a1 error) *MockServerInstance_Logs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServerInstance_Logs_Call) RunAndReturn(run func(context.Context, server.LogOptions) (server.LogReader, error)) *MockServerInstance_Logs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: purgeData
+func (_m *MockServerInstance) Remove(purgeData bool) error {
+	ret := _m.Called(purgeData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = rf(purgeData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServerInstance_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockServerInstance_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - purgeData bool
+func (_e *MockServerInstance_Expecter) Remove(purgeData interface{}) *MockServerInstance_Remove_Call {
+	return &MockServerInstance_Remove_Call{Call: _e.mock.On("Remove", purgeData)}
+}
+
+func (_c *MockServerInstance_Remove_Call) Run(run func(purgeData bool)) *MockServerInstance_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Remove_Call) Return(_a0 error) *MockServerInstance_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServerInstance_Remove_Call) RunAndReturn(run func(bool) error) *MockServerInstance_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restart provides a mock function with no fields
+func (_m *MockServerInstance) Restart() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServerInstance_Restart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restart'
+type MockServerInstance_Restart_Call struct {
+	*mock.Call
+}
+
+// Restart is a helper method to define mock.On call
+func (_e *MockServerInstance_Expecter) Restart() *MockServerInstance_Restart_Call {
+	return &MockServerInstance_Restart_Call{Call: _e.mock.On("Restart")}
+}
+
+func (_c *MockServerInstance_Restart_Call) Run(run func()) *MockServerInstance_Restart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Restart_Call) Return(_a0 error) *MockServerInstance_Restart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServerInstance_Restart_Call) RunAndReturn(run func() error) *MockServerInstance_Restart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with no fields
 func (_m *MockServerInstance) Start() error {
 	ret := _m.Called()
@@ -237,6 +597,65 @@ func (_c *MockServerInstance_Start_Call) RunAndReturn(run func() error) *MockSer
 	return _c
 }
 
+// Stats provides a mock function with given fields: ctx, follow
+func (_m *MockServerInstance) Stats(ctx context.Context, follow bool) (server.StatsReader, error) {
+	ret := _m.Called(ctx, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 server.StatsReader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (server.StatsReader, error)); ok {
+		return rf(ctx, follow)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) server.StatsReader); ok {
+		r0 = rf(ctx, follow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.StatsReader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, follow)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServerInstance_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type MockServerInstance_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follow bool
+func (_e *MockServerInstance_Expecter) Stats(ctx interface{}, follow interface{}) *MockServerInstance_Stats_Call {
+	return &MockServerInstance_Stats_Call{Call: _e.mock.On("Stats", ctx, follow)}
+}
+
+func (_c *MockServerInstance_Stats_Call) Run(run func(ctx context.Context, follow bool)) *MockServerInstance_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Stats_Call) Return(_a0 server.StatsReader, _a1 error) *MockServerInstance_Stats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServerInstance_Stats_Call) RunAndReturn(run func(context.Context, bool) (server.StatsReader, error)) *MockServerInstance_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Status provides a mock function with no fields
 func (_m *MockServerInstance) Status() server.ServerInstanceStatus {
 	ret := _m.Called()
@@ -282,6 +701,51 @@ func (_c *MockServerInstance_Status_Call) RunAndReturn(run func() server.ServerI
 	return _c
 }
 
+// StatusReason provides a mock function with no fields
+func (_m *MockServerInstance) StatusReason() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatusReason")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockServerInstance_StatusReason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatusReason'
+type MockServerInstance_StatusReason_Call struct {
+	*mock.Call
+}
+
+// StatusReason is a helper method to define mock.On call
+func (_e *MockServerInstance_Expecter) StatusReason() *MockServerInstance_StatusReason_Call {
+	return &MockServerInstance_StatusReason_Call{Call: _e.mock.On("StatusReason")}
+}
+
+func (_c *MockServerInstance_StatusReason_Call) Run(run func()) *MockServerInstance_StatusReason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_StatusReason_Call) Return(_a0 string) *MockServerInstance_StatusReason_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServerInstance_StatusReason_Call) RunAndReturn(run func() string) *MockServerInstance_StatusReason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stop provides a mock function with no fields
 func (_m *MockServerInstance) Stop() error {
 	ret := _m.Called()
@@ -327,6 +791,109 @@ func (_c *MockServerInstance_Stop_Call) RunAndReturn(run func() error) *MockServ
 	return _c
 }
 
+// Update provides a mock function with given fields: _a0
+func (_m *MockServerInstance) Update(_a0 server.ServerInstanceConfig) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(server.ServerInstanceConfig) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServerInstance_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockServerInstance_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - _a0 server.ServerInstanceConfig
+func (_e *MockServerInstance_Expecter) Update(_a0 interface{}) *MockServerInstance_Update_Call {
+	return &MockServerInstance_Update_Call{Call: _e.mock.On("Update", _a0)}
+}
+
+func (_c *MockServerInstance_Update_Call) Run(run func(_a0 server.ServerInstanceConfig)) *MockServerInstance_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(server.ServerInstanceConfig))
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_Update_Call) Return(_a0 error) *MockServerInstance_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServerInstance_Update_Call) RunAndReturn(run func(server.ServerInstanceConfig) error) *MockServerInstance_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateImage provides a mock function with no fields
+func (_m *MockServerInstance) UpdateImage() (*server.ImageUpdate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateImage")
+	}
+
+	var r0 *server.ImageUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*server.ImageUpdate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *server.ImageUpdate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*server.ImageUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServerInstance_UpdateImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateImage'
+type MockServerInstance_UpdateImage_Call struct {
+	*mock.Call
+}
+
+// UpdateImage is a helper method to define mock.On call
+func (_e *MockServerInstance_Expecter) UpdateImage() *MockServerInstance_UpdateImage_Call {
+	return &MockServerInstance_UpdateImage_Call{Call: _e.mock.On("UpdateImage")}
+}
+
+func (_c *MockServerInstance_UpdateImage_Call) Run(run func()) *MockServerInstance_UpdateImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerInstance_UpdateImage_Call) Return(_a0 *server.ImageUpdate, _a1 error) *MockServerInstance_UpdateImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServerInstance_UpdateImage_Call) RunAndReturn(run func() (*server.ImageUpdate, error)) *MockServerInstance_UpdateImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockServerInstance creates a new instance of MockServerInstance. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockServerInstance(t interface {
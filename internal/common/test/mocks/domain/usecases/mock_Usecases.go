@@ -4,10 +4,18 @@ package usecases
 
 import (
 	context "context"
-	server "oppossome/serverpouch/internal/domain/server"
+	auth "oppossome/serverpouch/internal/domain/auth"
+
+	events "oppossome/serverpouch/internal/common/events"
 
 	mock "github.com/stretchr/testify/mock"
 
+	server "oppossome/serverpouch/internal/domain/server"
+
+	time "time"
+
+	usecases "oppossome/serverpouch/internal/domain/usecases"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -24,6 +32,124 @@ func (_m *MockUsecases) EXPECT() *MockUsecases_Expecter {
 	return &MockUsecases_Expecter{mock: &_m.Mock}
 }
 
+// Authenticate provides a mock function with given fields: ctx, secret
+func (_m *MockUsecases) Authenticate(ctx context.Context, secret string) (*auth.User, error) {
+	ret := _m.Called(ctx, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*auth.User, error)); ok {
+		return rf(ctx, secret)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.User); ok {
+		r0 = rf(ctx, secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockUsecases_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - secret string
+func (_e *MockUsecases_Expecter) Authenticate(ctx interface{}, secret interface{}) *MockUsecases_Authenticate_Call {
+	return &MockUsecases_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, secret)}
+}
+
+func (_c *MockUsecases_Authenticate_Call) Run(run func(ctx context.Context, secret string)) *MockUsecases_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_Authenticate_Call) Return(_a0 *auth.User, _a1 error) *MockUsecases_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_Authenticate_Call) RunAndReturn(run func(context.Context, string) (*auth.User, error)) *MockUsecases_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckServerImage provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) CheckServerImage(_a0 context.Context, _a1 uuid.UUID) (*server.ImageUpdate, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckServerImage")
+	}
+
+	var r0 *server.ImageUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*server.ImageUpdate, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *server.ImageUpdate); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*server.ImageUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_CheckServerImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckServerImage'
+type MockUsecases_CheckServerImage_Call struct {
+	*mock.Call
+}
+
+// CheckServerImage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) CheckServerImage(_a0 interface{}, _a1 interface{}) *MockUsecases_CheckServerImage_Call {
+	return &MockUsecases_CheckServerImage_Call{Call: _e.mock.On("CheckServerImage", _a0, _a1)}
+}
+
+func (_c *MockUsecases_CheckServerImage_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_CheckServerImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_CheckServerImage_Call) Return(_a0 *server.ImageUpdate, _a1 error) *MockUsecases_CheckServerImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_CheckServerImage_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*server.ImageUpdate, error)) *MockUsecases_CheckServerImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockUsecases) Close() {
 	_m.Called()
@@ -56,6 +182,73 @@ func (_c *MockUsecases_Close_Call) RunAndReturn(run func()) *MockUsecases_Close_
 	return _c
 }
 
+// CreateAPIToken provides a mock function with given fields: ctx, userID, name
+func (_m *MockUsecases) CreateAPIToken(ctx context.Context, userID uuid.UUID, name string) (*auth.APIToken, string, error) {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIToken")
+	}
+
+	var r0 *auth.APIToken
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*auth.APIToken, string, error)); ok {
+		return rf(ctx, userID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *auth.APIToken); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) string); ok {
+		r1 = rf(ctx, userID, name)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string) error); ok {
+		r2 = rf(ctx, userID, name)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUsecases_CreateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIToken'
+type MockUsecases_CreateAPIToken_Call struct {
+	*mock.Call
+}
+
+// CreateAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - name string
+func (_e *MockUsecases_Expecter) CreateAPIToken(ctx interface{}, userID interface{}, name interface{}) *MockUsecases_CreateAPIToken_Call {
+	return &MockUsecases_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, userID, name)}
+}
+
+func (_c *MockUsecases_CreateAPIToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, name string)) *MockUsecases_CreateAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_CreateAPIToken_Call) Return(_a0 *auth.APIToken, _a1 string, _a2 error) *MockUsecases_CreateAPIToken_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUsecases_CreateAPIToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*auth.APIToken, string, error)) *MockUsecases_CreateAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateServer provides a mock function with given fields: _a0, _a1
 func (_m *MockUsecases) CreateServer(_a0 context.Context, _a1 server.ServerInstanceConfig) (server.ServerInstance, error) {
 	ret := _m.Called(_a0, _a1)
@@ -115,29 +308,29 @@ func (_c *MockUsecases_CreateServer_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// GetServer provides a mock function with given fields: _a0, _a1
-func (_m *MockUsecases) GetServer(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstance, error) {
-	ret := _m.Called(_a0, _a1)
+// CreateUser provides a mock function with given fields: ctx, name, isAdmin
+func (_m *MockUsecases) CreateUser(ctx context.Context, name string, isAdmin bool) (*auth.User, error) {
+	ret := _m.Called(ctx, name, isAdmin)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetServer")
+		panic("no return value specified for CreateUser")
 	}
 
-	var r0 server.ServerInstance
+	var r0 *auth.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstance, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*auth.User, error)); ok {
+		return rf(ctx, name, isAdmin)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstance); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *auth.User); ok {
+		r0 = rf(ctx, name, isAdmin)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(server.ServerInstance)
+			r0 = ret.Get(0).(*auth.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, name, isAdmin)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -145,79 +338,1197 @@ func (_m *MockUsecases) GetServer(_a0 context.Context, _a1 uuid.UUID) (server.Se
 	return r0, r1
 }
 
-// MockUsecases_GetServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServer'
-type MockUsecases_GetServer_Call struct {
+// MockUsecases_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockUsecases_CreateUser_Call struct {
 	*mock.Call
 }
 
-// GetServer is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 uuid.UUID
-func (_e *MockUsecases_Expecter) GetServer(_a0 interface{}, _a1 interface{}) *MockUsecases_GetServer_Call {
-	return &MockUsecases_GetServer_Call{Call: _e.mock.On("GetServer", _a0, _a1)}
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - isAdmin bool
+func (_e *MockUsecases_Expecter) CreateUser(ctx interface{}, name interface{}, isAdmin interface{}) *MockUsecases_CreateUser_Call {
+	return &MockUsecases_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, name, isAdmin)}
 }
 
-func (_c *MockUsecases_GetServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_GetServer_Call {
+func (_c *MockUsecases_CreateUser_Call) Run(run func(ctx context.Context, name string, isAdmin bool)) *MockUsecases_CreateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
 	})
 	return _c
 }
 
-func (_c *MockUsecases_GetServer_Call) Return(_a0 server.ServerInstance, _a1 error) *MockUsecases_GetServer_Call {
+func (_c *MockUsecases_CreateUser_Call) Return(_a0 *auth.User, _a1 error) *MockUsecases_CreateUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockUsecases_GetServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstance, error)) *MockUsecases_GetServer_Call {
+func (_c *MockUsecases_CreateUser_Call) RunAndReturn(run func(context.Context, string, bool) (*auth.User, error)) *MockUsecases_CreateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListServers provides a mock function with given fields: _a0
-func (_m *MockUsecases) ListServers(_a0 context.Context) []server.ServerInstance {
-	ret := _m.Called(_a0)
+// DeleteServer provides a mock function with given fields: ctx, id, purgeData
+func (_m *MockUsecases) DeleteServer(ctx context.Context, id uuid.UUID, purgeData bool) error {
+	ret := _m.Called(ctx, id, purgeData)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListServers")
+		panic("no return value specified for DeleteServer")
 	}
 
-	var r0 []server.ServerInstance
-	if rf, ok := ret.Get(0).(func(context.Context) []server.ServerInstance); ok {
-		r0 = rf(_a0)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, id, purgeData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUsecases_DeleteServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServer'
+type MockUsecases_DeleteServer_Call struct {
+	*mock.Call
+}
+
+// DeleteServer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - purgeData bool
+func (_e *MockUsecases_Expecter) DeleteServer(ctx interface{}, id interface{}, purgeData interface{}) *MockUsecases_DeleteServer_Call {
+	return &MockUsecases_DeleteServer_Call{Call: _e.mock.On("DeleteServer", ctx, id, purgeData)}
+}
+
+func (_c *MockUsecases_DeleteServer_Call) Run(run func(ctx context.Context, id uuid.UUID, purgeData bool)) *MockUsecases_DeleteServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_DeleteServer_Call) Return(_a0 error) *MockUsecases_DeleteServer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsecases_DeleteServer_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool) error) *MockUsecases_DeleteServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServerGrant provides a mock function with given fields: ctx, userID, serverID
+func (_m *MockUsecases) DeleteServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID) (*auth.User, error) {
+	ret := _m.Called(ctx, userID, serverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServerGrant")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*auth.User, error)); ok {
+		return rf(ctx, userID, serverID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *auth.User); ok {
+		r0 = rf(ctx, userID, serverID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]server.ServerInstance)
+			r0 = ret.Get(0).(*auth.User)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, serverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_DeleteServerGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServerGrant'
+type MockUsecases_DeleteServerGrant_Call struct {
+	*mock.Call
+}
+
+// DeleteServerGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - serverID uuid.UUID
+func (_e *MockUsecases_Expecter) DeleteServerGrant(ctx interface{}, userID interface{}, serverID interface{}) *MockUsecases_DeleteServerGrant_Call {
+	return &MockUsecases_DeleteServerGrant_Call{Call: _e.mock.On("DeleteServerGrant", ctx, userID, serverID)}
+}
+
+func (_c *MockUsecases_DeleteServerGrant_Call) Run(run func(ctx context.Context, userID uuid.UUID, serverID uuid.UUID)) *MockUsecases_DeleteServerGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_DeleteServerGrant_Call) Return(_a0 *auth.User, _a1 error) *MockUsecases_DeleteServerGrant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_DeleteServerGrant_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*auth.User, error)) *MockUsecases_DeleteServerGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) DeleteUser(_a0 context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
 	return r0
 }
 
-// MockUsecases_ListServers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServers'
-type MockUsecases_ListServers_Call struct {
+// MockUsecases_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockUsecases_DeleteUser_Call struct {
 	*mock.Call
 }
 
-// ListServers is a helper method to define mock.On call
+// DeleteUser is a helper method to define mock.On call
 //   - _a0 context.Context
-func (_e *MockUsecases_Expecter) ListServers(_a0 interface{}) *MockUsecases_ListServers_Call {
-	return &MockUsecases_ListServers_Call{Call: _e.mock.On("ListServers", _a0)}
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) DeleteUser(_a0 interface{}, _a1 interface{}) *MockUsecases_DeleteUser_Call {
+	return &MockUsecases_DeleteUser_Call{Call: _e.mock.On("DeleteUser", _a0, _a1)}
 }
 
-func (_c *MockUsecases_ListServers_Call) Run(run func(_a0 context.Context)) *MockUsecases_ListServers_Call {
+func (_c *MockUsecases_DeleteUser_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_DeleteUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockUsecases_ListServers_Call) Return(_a0 []server.ServerInstance) *MockUsecases_ListServers_Call {
+func (_c *MockUsecases_DeleteUser_Call) Return(_a0 error) *MockUsecases_DeleteUser_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockUsecases_ListServers_Call) RunAndReturn(run func(context.Context) []server.ServerInstance) *MockUsecases_ListServers_Call {
+func (_c *MockUsecases_DeleteUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockUsecases_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIToken provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) GetAPIToken(_a0 context.Context, _a1 uuid.UUID) (*auth.APIToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIToken")
+	}
+
+	var r0 *auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*auth.APIToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *auth.APIToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_GetAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIToken'
+type MockUsecases_GetAPIToken_Call struct {
+	*mock.Call
+}
+
+// GetAPIToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) GetAPIToken(_a0 interface{}, _a1 interface{}) *MockUsecases_GetAPIToken_Call {
+	return &MockUsecases_GetAPIToken_Call{Call: _e.mock.On("GetAPIToken", _a0, _a1)}
+}
+
+func (_c *MockUsecases_GetAPIToken_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_GetAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_GetAPIToken_Call) Return(_a0 *auth.APIToken, _a1 error) *MockUsecases_GetAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_GetAPIToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*auth.APIToken, error)) *MockUsecases_GetAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServer provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) GetServer(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServer")
+	}
+
+	var r0 server.ServerInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_GetServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServer'
+type MockUsecases_GetServer_Call struct {
+	*mock.Call
+}
+
+// GetServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) GetServer(_a0 interface{}, _a1 interface{}) *MockUsecases_GetServer_Call {
+	return &MockUsecases_GetServer_Call{Call: _e.mock.On("GetServer", _a0, _a1)}
+}
+
+func (_c *MockUsecases_GetServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_GetServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_GetServer_Call) Return(_a0 server.ServerInstance, _a1 error) *MockUsecases_GetServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_GetServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstance, error)) *MockUsecases_GetServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServerStatsHistory provides a mock function with given fields: ctx, id, since, until
+func (_m *MockUsecases) GetServerStatsHistory(ctx context.Context, id uuid.UUID, since time.Time, until time.Time) (*usecases.StatsHistory, error) {
+	ret := _m.Called(ctx, id, since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServerStatsHistory")
+	}
+
+	var r0 *usecases.StatsHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (*usecases.StatsHistory, error)); ok {
+		return rf(ctx, id, since, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) *usecases.StatsHistory); ok {
+		r0 = rf(ctx, id, since, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecases.StatsHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, id, since, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_GetServerStatsHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServerStatsHistory'
+type MockUsecases_GetServerStatsHistory_Call struct {
+	*mock.Call
+}
+
+// GetServerStatsHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - since time.Time
+//   - until time.Time
+func (_e *MockUsecases_Expecter) GetServerStatsHistory(ctx interface{}, id interface{}, since interface{}, until interface{}) *MockUsecases_GetServerStatsHistory_Call {
+	return &MockUsecases_GetServerStatsHistory_Call{Call: _e.mock.On("GetServerStatsHistory", ctx, id, since, until)}
+}
+
+func (_c *MockUsecases_GetServerStatsHistory_Call) Run(run func(ctx context.Context, id uuid.UUID, since time.Time, until time.Time)) *MockUsecases_GetServerStatsHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_GetServerStatsHistory_Call) Return(_a0 *usecases.StatsHistory, _a1 error) *MockUsecases_GetServerStatsHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_GetServerStatsHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) (*usecases.StatsHistory, error)) *MockUsecases_GetServerStatsHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) GetUser(_a0 context.Context, _a1 uuid.UUID) (*auth.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*auth.User, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *auth.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockUsecases_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) GetUser(_a0 interface{}, _a1 interface{}) *MockUsecases_GetUser_Call {
+	return &MockUsecases_GetUser_Call{Call: _e.mock.On("GetUser", _a0, _a1)}
+}
+
+func (_c *MockUsecases_GetUser_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_GetUser_Call) Return(_a0 *auth.User, _a1 error) *MockUsecases_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_GetUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*auth.User, error)) *MockUsecases_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KillServer provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) KillServer(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KillServer")
+	}
+
+	var r0 server.ServerInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_KillServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KillServer'
+type MockUsecases_KillServer_Call struct {
+	*mock.Call
+}
+
+// KillServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) KillServer(_a0 interface{}, _a1 interface{}) *MockUsecases_KillServer_Call {
+	return &MockUsecases_KillServer_Call{Call: _e.mock.On("KillServer", _a0, _a1)}
+}
+
+func (_c *MockUsecases_KillServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_KillServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_KillServer_Call) Return(_a0 server.ServerInstance, _a1 error) *MockUsecases_KillServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_KillServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstance, error)) *MockUsecases_KillServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPITokens provides a mock function with given fields: _a0
+func (_m *MockUsecases) ListAPITokens(_a0 context.Context) ([]*auth.APIToken, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPITokens")
+	}
+
+	var r0 []*auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*auth.APIToken, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*auth.APIToken); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_ListAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPITokens'
+type MockUsecases_ListAPITokens_Call struct {
+	*mock.Call
+}
+
+// ListAPITokens is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockUsecases_Expecter) ListAPITokens(_a0 interface{}) *MockUsecases_ListAPITokens_Call {
+	return &MockUsecases_ListAPITokens_Call{Call: _e.mock.On("ListAPITokens", _a0)}
+}
+
+func (_c *MockUsecases_ListAPITokens_Call) Run(run func(_a0 context.Context)) *MockUsecases_ListAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_ListAPITokens_Call) Return(_a0 []*auth.APIToken, _a1 error) *MockUsecases_ListAPITokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_ListAPITokens_Call) RunAndReturn(run func(context.Context) ([]*auth.APIToken, error)) *MockUsecases_ListAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListServers provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) ListServers(_a0 context.Context, _a1 usecases.ListServersOptions) ([]server.ServerInstance, string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServers")
+	}
+
+	var r0 []server.ServerInstance
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ListServersOptions) ([]server.ServerInstance, string, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ListServersOptions) []server.ServerInstance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.ListServersOptions) string); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, usecases.ListServersOptions) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUsecases_ListServers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServers'
+type MockUsecases_ListServers_Call struct {
+	*mock.Call
+}
+
+// ListServers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 usecases.ListServersOptions
+func (_e *MockUsecases_Expecter) ListServers(_a0 interface{}, _a1 interface{}) *MockUsecases_ListServers_Call {
+	return &MockUsecases_ListServers_Call{Call: _e.mock.On("ListServers", _a0, _a1)}
+}
+
+func (_c *MockUsecases_ListServers_Call) Run(run func(_a0 context.Context, _a1 usecases.ListServersOptions)) *MockUsecases_ListServers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecases.ListServersOptions))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_ListServers_Call) Return(_a0 []server.ServerInstance, _a1 string, _a2 error) *MockUsecases_ListServers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUsecases_ListServers_Call) RunAndReturn(run func(context.Context, usecases.ListServersOptions) ([]server.ServerInstance, string, error)) *MockUsecases_ListServers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserAPITokens provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) ListUserAPITokens(_a0 context.Context, _a1 uuid.UUID) ([]*auth.APIToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAPITokens")
+	}
+
+	var r0 []*auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*auth.APIToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*auth.APIToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_ListUserAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAPITokens'
+type MockUsecases_ListUserAPITokens_Call struct {
+	*mock.Call
+}
+
+// ListUserAPITokens is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) ListUserAPITokens(_a0 interface{}, _a1 interface{}) *MockUsecases_ListUserAPITokens_Call {
+	return &MockUsecases_ListUserAPITokens_Call{Call: _e.mock.On("ListUserAPITokens", _a0, _a1)}
+}
+
+func (_c *MockUsecases_ListUserAPITokens_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_ListUserAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_ListUserAPITokens_Call) Return(_a0 []*auth.APIToken, _a1 error) *MockUsecases_ListUserAPITokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_ListUserAPITokens_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*auth.APIToken, error)) *MockUsecases_ListUserAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function with given fields: _a0
+func (_m *MockUsecases) ListUsers(_a0 context.Context) ([]*auth.User, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []*auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*auth.User, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*auth.User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockUsecases_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockUsecases_Expecter) ListUsers(_a0 interface{}) *MockUsecases_ListUsers_Call {
+	return &MockUsecases_ListUsers_Call{Call: _e.mock.On("ListUsers", _a0)}
+}
+
+func (_c *MockUsecases_ListUsers_Call) Run(run func(_a0 context.Context)) *MockUsecases_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_ListUsers_Call) Return(_a0 []*auth.User, _a1 error) *MockUsecases_ListUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_ListUsers_Call) RunAndReturn(run func(context.Context) ([]*auth.User, error)) *MockUsecases_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestartServer provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) RestartServer(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartServer")
+	}
+
+	var r0 server.ServerInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_RestartServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartServer'
+type MockUsecases_RestartServer_Call struct {
+	*mock.Call
+}
+
+// RestartServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) RestartServer(_a0 interface{}, _a1 interface{}) *MockUsecases_RestartServer_Call {
+	return &MockUsecases_RestartServer_Call{Call: _e.mock.On("RestartServer", _a0, _a1)}
+}
+
+func (_c *MockUsecases_RestartServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_RestartServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_RestartServer_Call) Return(_a0 server.ServerInstance, _a1 error) *MockUsecases_RestartServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_RestartServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstance, error)) *MockUsecases_RestartServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIToken provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) RevokeAPIToken(_a0 context.Context, _a1 uuid.UUID) (*auth.APIToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIToken")
+	}
+
+	var r0 *auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*auth.APIToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *auth.APIToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
+type MockUsecases_RevokeAPIToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) RevokeAPIToken(_a0 interface{}, _a1 interface{}) *MockUsecases_RevokeAPIToken_Call {
+	return &MockUsecases_RevokeAPIToken_Call{Call: _e.mock.On("RevokeAPIToken", _a0, _a1)}
+}
+
+func (_c *MockUsecases_RevokeAPIToken_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_RevokeAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_RevokeAPIToken_Call) Return(_a0 *auth.APIToken, _a1 error) *MockUsecases_RevokeAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_RevokeAPIToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*auth.APIToken, error)) *MockUsecases_RevokeAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetServerGrant provides a mock function with given fields: ctx, userID, serverID, role
+func (_m *MockUsecases) SetServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role) (*auth.User, error) {
+	ret := _m.Called(ctx, userID, serverID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetServerGrant")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, auth.Role) (*auth.User, error)); ok {
+		return rf(ctx, userID, serverID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, auth.Role) *auth.User); ok {
+		r0 = rf(ctx, userID, serverID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, auth.Role) error); ok {
+		r1 = rf(ctx, userID, serverID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_SetServerGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetServerGrant'
+type MockUsecases_SetServerGrant_Call struct {
+	*mock.Call
+}
+
+// SetServerGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - serverID uuid.UUID
+//   - role auth.Role
+func (_e *MockUsecases_Expecter) SetServerGrant(ctx interface{}, userID interface{}, serverID interface{}, role interface{}) *MockUsecases_SetServerGrant_Call {
+	return &MockUsecases_SetServerGrant_Call{Call: _e.mock.On("SetServerGrant", ctx, userID, serverID, role)}
+}
+
+func (_c *MockUsecases_SetServerGrant_Call) Run(run func(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role)) *MockUsecases_SetServerGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(auth.Role))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_SetServerGrant_Call) Return(_a0 *auth.User, _a1 error) *MockUsecases_SetServerGrant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_SetServerGrant_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, auth.Role) (*auth.User, error)) *MockUsecases_SetServerGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartServer provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) StartServer(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartServer")
+	}
+
+	var r0 server.ServerInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_StartServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartServer'
+type MockUsecases_StartServer_Call struct {
+	*mock.Call
+}
+
+// StartServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) StartServer(_a0 interface{}, _a1 interface{}) *MockUsecases_StartServer_Call {
+	return &MockUsecases_StartServer_Call{Call: _e.mock.On("StartServer", _a0, _a1)}
+}
+
+func (_c *MockUsecases_StartServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_StartServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_StartServer_Call) Return(_a0 server.ServerInstance, _a1 error) *MockUsecases_StartServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_StartServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstance, error)) *MockUsecases_StartServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StatusEvents provides a mock function with no fields
+func (_m *MockUsecases) StatusEvents() events.EventEmitter[usecases.ServerStatusEvent] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatusEvents")
+	}
+
+	var r0 events.EventEmitter[usecases.ServerStatusEvent]
+	if rf, ok := ret.Get(0).(func() events.EventEmitter[usecases.ServerStatusEvent]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(events.EventEmitter[usecases.ServerStatusEvent])
+		}
+	}
+
+	return r0
+}
+
+// MockUsecases_StatusEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatusEvents'
+type MockUsecases_StatusEvents_Call struct {
+	*mock.Call
+}
+
+// StatusEvents is a helper method to define mock.On call
+func (_e *MockUsecases_Expecter) StatusEvents() *MockUsecases_StatusEvents_Call {
+	return &MockUsecases_StatusEvents_Call{Call: _e.mock.On("StatusEvents")}
+}
+
+func (_c *MockUsecases_StatusEvents_Call) Run(run func()) *MockUsecases_StatusEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUsecases_StatusEvents_Call) Return(_a0 events.EventEmitter[usecases.ServerStatusEvent]) *MockUsecases_StatusEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUsecases_StatusEvents_Call) RunAndReturn(run func() events.EventEmitter[usecases.ServerStatusEvent]) *MockUsecases_StatusEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopServer provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) StopServer(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopServer")
+	}
+
+	var r0 server.ServerInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_StopServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopServer'
+type MockUsecases_StopServer_Call struct {
+	*mock.Call
+}
+
+// StopServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) StopServer(_a0 interface{}, _a1 interface{}) *MockUsecases_StopServer_Call {
+	return &MockUsecases_StopServer_Call{Call: _e.mock.On("StopServer", _a0, _a1)}
+}
+
+func (_c *MockUsecases_StopServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_StopServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_StopServer_Call) Return(_a0 server.ServerInstance, _a1 error) *MockUsecases_StopServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_StopServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstance, error)) *MockUsecases_StopServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateServer provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockUsecases) UpdateServer(_a0 context.Context, _a1 uuid.UUID, _a2 server.ServerInstanceConfig) (server.ServerInstance, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateServer")
+	}
+
+	var r0 server.ServerInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, server.ServerInstanceConfig) (server.ServerInstance, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, server.ServerInstanceConfig) server.ServerInstance); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, server.ServerInstanceConfig) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsecases_UpdateServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateServer'
+type MockUsecases_UpdateServer_Call struct {
+	*mock.Call
+}
+
+// UpdateServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+//   - _a2 server.ServerInstanceConfig
+func (_e *MockUsecases_Expecter) UpdateServer(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockUsecases_UpdateServer_Call {
+	return &MockUsecases_UpdateServer_Call{Call: _e.mock.On("UpdateServer", _a0, _a1, _a2)}
+}
+
+func (_c *MockUsecases_UpdateServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID, _a2 server.ServerInstanceConfig)) *MockUsecases_UpdateServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(server.ServerInstanceConfig))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_UpdateServer_Call) Return(_a0 server.ServerInstance, _a1 error) *MockUsecases_UpdateServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsecases_UpdateServer_Call) RunAndReturn(run func(context.Context, uuid.UUID, server.ServerInstanceConfig) (server.ServerInstance, error)) *MockUsecases_UpdateServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateServerImage provides a mock function with given fields: _a0, _a1
+func (_m *MockUsecases) UpdateServerImage(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstance, *server.ImageUpdate, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateServerImage")
+	}
+
+	var r0 server.ServerInstance
+	var r1 *server.ImageUpdate
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstance, *server.ImageUpdate, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) *server.ImageUpdate); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*server.ImageUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUsecases_UpdateServerImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateServerImage'
+type MockUsecases_UpdateServerImage_Call struct {
+	*mock.Call
+}
+
+// UpdateServerImage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockUsecases_Expecter) UpdateServerImage(_a0 interface{}, _a1 interface{}) *MockUsecases_UpdateServerImage_Call {
+	return &MockUsecases_UpdateServerImage_Call{Call: _e.mock.On("UpdateServerImage", _a0, _a1)}
+}
+
+func (_c *MockUsecases_UpdateServerImage_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockUsecases_UpdateServerImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUsecases_UpdateServerImage_Call) Return(_a0 server.ServerInstance, _a1 *server.ImageUpdate, _a2 error) *MockUsecases_UpdateServerImage_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUsecases_UpdateServerImage_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstance, *server.ImageUpdate, error)) *MockUsecases_UpdateServerImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,1353 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package database
+
+import (
+	context "context"
+	auth "oppossome/serverpouch/internal/domain/auth"
+
+	mock "github.com/stretchr/testify/mock"
+
+	server "oppossome/serverpouch/internal/domain/server"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatabase is an autogenerated mock type for the Database type
+type MockDatabase struct {
+	mock.Mock
+}
+
+type MockDatabase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatabase) EXPECT() *MockDatabase_Expecter {
+	return &MockDatabase_Expecter{mock: &_m.Mock}
+}
+
+// CreateAPIToken provides a mock function with given fields: ctx, userID, name, hash
+func (_m *MockDatabase) CreateAPIToken(ctx context.Context, userID uuid.UUID, name string, hash []byte) (*auth.APIToken, error) {
+	ret := _m.Called(ctx, userID, name, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIToken")
+	}
+
+	var r0 *auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []byte) (*auth.APIToken, error)); ok {
+		return rf(ctx, userID, name, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []byte) *auth.APIToken); ok {
+		r0 = rf(ctx, userID, name, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []byte) error); ok {
+		r1 = rf(ctx, userID, name, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_CreateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIToken'
+type MockDatabase_CreateAPIToken_Call struct {
+	*mock.Call
+}
+
+// CreateAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - name string
+//   - hash []byte
+func (_e *MockDatabase_Expecter) CreateAPIToken(ctx interface{}, userID interface{}, name interface{}, hash interface{}) *MockDatabase_CreateAPIToken_Call {
+	return &MockDatabase_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, userID, name, hash)}
+}
+
+func (_c *MockDatabase_CreateAPIToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, name string, hash []byte)) *MockDatabase_CreateAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_CreateAPIToken_Call) Return(_a0 *auth.APIToken, _a1 error) *MockDatabase_CreateAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_CreateAPIToken_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []byte) (*auth.APIToken, error)) *MockDatabase_CreateAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServer provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) CreateServer(_a0 context.Context, _a1 server.ServerInstanceConfig) (server.ServerInstanceConfig, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServer")
+	}
+
+	var r0 server.ServerInstanceConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, server.ServerInstanceConfig) (server.ServerInstanceConfig, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, server.ServerInstanceConfig) server.ServerInstanceConfig); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstanceConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, server.ServerInstanceConfig) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_CreateServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServer'
+type MockDatabase_CreateServer_Call struct {
+	*mock.Call
+}
+
+// CreateServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 server.ServerInstanceConfig
+func (_e *MockDatabase_Expecter) CreateServer(_a0 interface{}, _a1 interface{}) *MockDatabase_CreateServer_Call {
+	return &MockDatabase_CreateServer_Call{Call: _e.mock.On("CreateServer", _a0, _a1)}
+}
+
+func (_c *MockDatabase_CreateServer_Call) Run(run func(_a0 context.Context, _a1 server.ServerInstanceConfig)) *MockDatabase_CreateServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(server.ServerInstanceConfig))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_CreateServer_Call) Return(_a0 server.ServerInstanceConfig, _a1 error) *MockDatabase_CreateServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_CreateServer_Call) RunAndReturn(run func(context.Context, server.ServerInstanceConfig) (server.ServerInstanceConfig, error)) *MockDatabase_CreateServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServerStats provides a mock function with given fields: ctx, serverID, resolution, stats
+func (_m *MockDatabase) CreateServerStats(ctx context.Context, serverID uuid.UUID, resolution time.Duration, stats server.Stats) error {
+	ret := _m.Called(ctx, serverID, resolution, stats)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServerStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, server.Stats) error); ok {
+		r0 = rf(ctx, serverID, resolution, stats)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_CreateServerStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServerStats'
+type MockDatabase_CreateServerStats_Call struct {
+	*mock.Call
+}
+
+// CreateServerStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID uuid.UUID
+//   - resolution time.Duration
+//   - stats server.Stats
+func (_e *MockDatabase_Expecter) CreateServerStats(ctx interface{}, serverID interface{}, resolution interface{}, stats interface{}) *MockDatabase_CreateServerStats_Call {
+	return &MockDatabase_CreateServerStats_Call{Call: _e.mock.On("CreateServerStats", ctx, serverID, resolution, stats)}
+}
+
+func (_c *MockDatabase_CreateServerStats_Call) Run(run func(ctx context.Context, serverID uuid.UUID, resolution time.Duration, stats server.Stats)) *MockDatabase_CreateServerStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Duration), args[3].(server.Stats))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_CreateServerStats_Call) Return(_a0 error) *MockDatabase_CreateServerStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_CreateServerStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Duration, server.Stats) error) *MockDatabase_CreateServerStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, name, isAdmin
+func (_m *MockDatabase) CreateUser(ctx context.Context, name string, isAdmin bool) (*auth.User, error) {
+	ret := _m.Called(ctx, name, isAdmin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*auth.User, error)); ok {
+		return rf(ctx, name, isAdmin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *auth.User); ok {
+		r0 = rf(ctx, name, isAdmin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, name, isAdmin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockDatabase_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - isAdmin bool
+func (_e *MockDatabase_Expecter) CreateUser(ctx interface{}, name interface{}, isAdmin interface{}) *MockDatabase_CreateUser_Call {
+	return &MockDatabase_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, name, isAdmin)}
+}
+
+func (_c *MockDatabase_CreateUser_Call) Run(run func(ctx context.Context, name string, isAdmin bool)) *MockDatabase_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_CreateUser_Call) Return(_a0 *auth.User, _a1 error) *MockDatabase_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_CreateUser_Call) RunAndReturn(run func(context.Context, string, bool) (*auth.User, error)) *MockDatabase_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServer provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) DeleteServer(_a0 context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_DeleteServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServer'
+type MockDatabase_DeleteServer_Call struct {
+	*mock.Call
+}
+
+// DeleteServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) DeleteServer(_a0 interface{}, _a1 interface{}) *MockDatabase_DeleteServer_Call {
+	return &MockDatabase_DeleteServer_Call{Call: _e.mock.On("DeleteServer", _a0, _a1)}
+}
+
+func (_c *MockDatabase_DeleteServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_DeleteServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_DeleteServer_Call) Return(_a0 error) *MockDatabase_DeleteServer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_DeleteServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockDatabase_DeleteServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServerGrant provides a mock function with given fields: ctx, userID, serverID
+func (_m *MockDatabase) DeleteServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, serverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServerGrant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, serverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_DeleteServerGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServerGrant'
+type MockDatabase_DeleteServerGrant_Call struct {
+	*mock.Call
+}
+
+// DeleteServerGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - serverID uuid.UUID
+func (_e *MockDatabase_Expecter) DeleteServerGrant(ctx interface{}, userID interface{}, serverID interface{}) *MockDatabase_DeleteServerGrant_Call {
+	return &MockDatabase_DeleteServerGrant_Call{Call: _e.mock.On("DeleteServerGrant", ctx, userID, serverID)}
+}
+
+func (_c *MockDatabase_DeleteServerGrant_Call) Run(run func(ctx context.Context, userID uuid.UUID, serverID uuid.UUID)) *MockDatabase_DeleteServerGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_DeleteServerGrant_Call) Return(_a0 error) *MockDatabase_DeleteServerGrant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_DeleteServerGrant_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatabase_DeleteServerGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServerStats provides a mock function with given fields: ctx, resolution, before
+func (_m *MockDatabase) DeleteServerStats(ctx context.Context, resolution time.Duration, before time.Time) error {
+	ret := _m.Called(ctx, resolution, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServerStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, time.Time) error); ok {
+		r0 = rf(ctx, resolution, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_DeleteServerStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServerStats'
+type MockDatabase_DeleteServerStats_Call struct {
+	*mock.Call
+}
+
+// DeleteServerStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resolution time.Duration
+//   - before time.Time
+func (_e *MockDatabase_Expecter) DeleteServerStats(ctx interface{}, resolution interface{}, before interface{}) *MockDatabase_DeleteServerStats_Call {
+	return &MockDatabase_DeleteServerStats_Call{Call: _e.mock.On("DeleteServerStats", ctx, resolution, before)}
+}
+
+func (_c *MockDatabase_DeleteServerStats_Call) Run(run func(ctx context.Context, resolution time.Duration, before time.Time)) *MockDatabase_DeleteServerStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_DeleteServerStats_Call) Return(_a0 error) *MockDatabase_DeleteServerStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_DeleteServerStats_Call) RunAndReturn(run func(context.Context, time.Duration, time.Time) error) *MockDatabase_DeleteServerStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) DeleteUser(_a0 context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockDatabase_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) DeleteUser(_a0 interface{}, _a1 interface{}) *MockDatabase_DeleteUser_Call {
+	return &MockDatabase_DeleteUser_Call{Call: _e.mock.On("DeleteUser", _a0, _a1)}
+}
+
+func (_c *MockDatabase_DeleteUser_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_DeleteUser_Call) Return(_a0 error) *MockDatabase_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_DeleteUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockDatabase_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownsampleServerStats provides a mock function with given fields: ctx, from, to, since, before
+func (_m *MockDatabase) DownsampleServerStats(ctx context.Context, from time.Duration, to time.Duration, since time.Time, before time.Time) error {
+	ret := _m.Called(ctx, from, to, since, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownsampleServerStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, time.Duration, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, from, to, since, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_DownsampleServerStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownsampleServerStats'
+type MockDatabase_DownsampleServerStats_Call struct {
+	*mock.Call
+}
+
+// DownsampleServerStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Duration
+//   - to time.Duration
+//   - since time.Time
+//   - before time.Time
+func (_e *MockDatabase_Expecter) DownsampleServerStats(ctx interface{}, from interface{}, to interface{}, since interface{}, before interface{}) *MockDatabase_DownsampleServerStats_Call {
+	return &MockDatabase_DownsampleServerStats_Call{Call: _e.mock.On("DownsampleServerStats", ctx, from, to, since, before)}
+}
+
+func (_c *MockDatabase_DownsampleServerStats_Call) Run(run func(ctx context.Context, from time.Duration, to time.Duration, since time.Time, before time.Time)) *MockDatabase_DownsampleServerStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(time.Duration), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_DownsampleServerStats_Call) Return(_a0 error) *MockDatabase_DownsampleServerStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_DownsampleServerStats_Call) RunAndReturn(run func(context.Context, time.Duration, time.Duration, time.Time, time.Time) error) *MockDatabase_DownsampleServerStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIToken provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) GetAPIToken(_a0 context.Context, _a1 uuid.UUID) (*auth.APIToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIToken")
+	}
+
+	var r0 *auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*auth.APIToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *auth.APIToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_GetAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIToken'
+type MockDatabase_GetAPIToken_Call struct {
+	*mock.Call
+}
+
+// GetAPIToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) GetAPIToken(_a0 interface{}, _a1 interface{}) *MockDatabase_GetAPIToken_Call {
+	return &MockDatabase_GetAPIToken_Call{Call: _e.mock.On("GetAPIToken", _a0, _a1)}
+}
+
+func (_c *MockDatabase_GetAPIToken_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_GetAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_GetAPIToken_Call) Return(_a0 *auth.APIToken, _a1 error) *MockDatabase_GetAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_GetAPIToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*auth.APIToken, error)) *MockDatabase_GetAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPITokenByHash provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) GetAPITokenByHash(_a0 context.Context, _a1 []byte) (*auth.APIToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPITokenByHash")
+	}
+
+	var r0 *auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (*auth.APIToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *auth.APIToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_GetAPITokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPITokenByHash'
+type MockDatabase_GetAPITokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetAPITokenByHash is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []byte
+func (_e *MockDatabase_Expecter) GetAPITokenByHash(_a0 interface{}, _a1 interface{}) *MockDatabase_GetAPITokenByHash_Call {
+	return &MockDatabase_GetAPITokenByHash_Call{Call: _e.mock.On("GetAPITokenByHash", _a0, _a1)}
+}
+
+func (_c *MockDatabase_GetAPITokenByHash_Call) Run(run func(_a0 context.Context, _a1 []byte)) *MockDatabase_GetAPITokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_GetAPITokenByHash_Call) Return(_a0 *auth.APIToken, _a1 error) *MockDatabase_GetAPITokenByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_GetAPITokenByHash_Call) RunAndReturn(run func(context.Context, []byte) (*auth.APIToken, error)) *MockDatabase_GetAPITokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServer provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) GetServer(_a0 context.Context, _a1 uuid.UUID) (server.ServerInstanceConfig, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServer")
+	}
+
+	var r0 server.ServerInstanceConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (server.ServerInstanceConfig, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) server.ServerInstanceConfig); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstanceConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_GetServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServer'
+type MockDatabase_GetServer_Call struct {
+	*mock.Call
+}
+
+// GetServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) GetServer(_a0 interface{}, _a1 interface{}) *MockDatabase_GetServer_Call {
+	return &MockDatabase_GetServer_Call{Call: _e.mock.On("GetServer", _a0, _a1)}
+}
+
+func (_c *MockDatabase_GetServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_GetServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_GetServer_Call) Return(_a0 server.ServerInstanceConfig, _a1 error) *MockDatabase_GetServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_GetServer_Call) RunAndReturn(run func(context.Context, uuid.UUID) (server.ServerInstanceConfig, error)) *MockDatabase_GetServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) GetUser(_a0 context.Context, _a1 uuid.UUID) (*auth.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*auth.User, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *auth.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockDatabase_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) GetUser(_a0 interface{}, _a1 interface{}) *MockDatabase_GetUser_Call {
+	return &MockDatabase_GetUser_Call{Call: _e.mock.On("GetUser", _a0, _a1)}
+}
+
+func (_c *MockDatabase_GetUser_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_GetUser_Call) Return(_a0 *auth.User, _a1 error) *MockDatabase_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_GetUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*auth.User, error)) *MockDatabase_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPITokens provides a mock function with given fields: _a0
+func (_m *MockDatabase) ListAPITokens(_a0 context.Context) ([]*auth.APIToken, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPITokens")
+	}
+
+	var r0 []*auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*auth.APIToken, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*auth.APIToken); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_ListAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPITokens'
+type MockDatabase_ListAPITokens_Call struct {
+	*mock.Call
+}
+
+// ListAPITokens is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockDatabase_Expecter) ListAPITokens(_a0 interface{}) *MockDatabase_ListAPITokens_Call {
+	return &MockDatabase_ListAPITokens_Call{Call: _e.mock.On("ListAPITokens", _a0)}
+}
+
+func (_c *MockDatabase_ListAPITokens_Call) Run(run func(_a0 context.Context)) *MockDatabase_ListAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ListAPITokens_Call) Return(_a0 []*auth.APIToken, _a1 error) *MockDatabase_ListAPITokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_ListAPITokens_Call) RunAndReturn(run func(context.Context) ([]*auth.APIToken, error)) *MockDatabase_ListAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListServerStats provides a mock function with given fields: ctx, serverID, resolution, since, until
+func (_m *MockDatabase) ListServerStats(ctx context.Context, serverID uuid.UUID, resolution time.Duration, since time.Time, until time.Time) ([]server.Stats, error) {
+	ret := _m.Called(ctx, serverID, resolution, since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServerStats")
+	}
+
+	var r0 []server.Stats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, time.Time, time.Time) ([]server.Stats, error)); ok {
+		return rf(ctx, serverID, resolution, since, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, time.Time, time.Time) []server.Stats); ok {
+		r0 = rf(ctx, serverID, resolution, since, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]server.Stats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, serverID, resolution, since, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_ListServerStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServerStats'
+type MockDatabase_ListServerStats_Call struct {
+	*mock.Call
+}
+
+// ListServerStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serverID uuid.UUID
+//   - resolution time.Duration
+//   - since time.Time
+//   - until time.Time
+func (_e *MockDatabase_Expecter) ListServerStats(ctx interface{}, serverID interface{}, resolution interface{}, since interface{}, until interface{}) *MockDatabase_ListServerStats_Call {
+	return &MockDatabase_ListServerStats_Call{Call: _e.mock.On("ListServerStats", ctx, serverID, resolution, since, until)}
+}
+
+func (_c *MockDatabase_ListServerStats_Call) Run(run func(ctx context.Context, serverID uuid.UUID, resolution time.Duration, since time.Time, until time.Time)) *MockDatabase_ListServerStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Duration), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ListServerStats_Call) Return(_a0 []server.Stats, _a1 error) *MockDatabase_ListServerStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_ListServerStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Duration, time.Time, time.Time) ([]server.Stats, error)) *MockDatabase_ListServerStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListServers provides a mock function with given fields: _a0
+func (_m *MockDatabase) ListServers(_a0 context.Context) ([]server.ServerInstanceConfig, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServers")
+	}
+
+	var r0 []server.ServerInstanceConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]server.ServerInstanceConfig, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []server.ServerInstanceConfig); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]server.ServerInstanceConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_ListServers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListServers'
+type MockDatabase_ListServers_Call struct {
+	*mock.Call
+}
+
+// ListServers is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockDatabase_Expecter) ListServers(_a0 interface{}) *MockDatabase_ListServers_Call {
+	return &MockDatabase_ListServers_Call{Call: _e.mock.On("ListServers", _a0)}
+}
+
+func (_c *MockDatabase_ListServers_Call) Run(run func(_a0 context.Context)) *MockDatabase_ListServers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ListServers_Call) Return(_a0 []server.ServerInstanceConfig, _a1 error) *MockDatabase_ListServers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_ListServers_Call) RunAndReturn(run func(context.Context) ([]server.ServerInstanceConfig, error)) *MockDatabase_ListServers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserAPITokens provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) ListUserAPITokens(_a0 context.Context, _a1 uuid.UUID) ([]*auth.APIToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAPITokens")
+	}
+
+	var r0 []*auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*auth.APIToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*auth.APIToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_ListUserAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAPITokens'
+type MockDatabase_ListUserAPITokens_Call struct {
+	*mock.Call
+}
+
+// ListUserAPITokens is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) ListUserAPITokens(_a0 interface{}, _a1 interface{}) *MockDatabase_ListUserAPITokens_Call {
+	return &MockDatabase_ListUserAPITokens_Call{Call: _e.mock.On("ListUserAPITokens", _a0, _a1)}
+}
+
+func (_c *MockDatabase_ListUserAPITokens_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_ListUserAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ListUserAPITokens_Call) Return(_a0 []*auth.APIToken, _a1 error) *MockDatabase_ListUserAPITokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_ListUserAPITokens_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*auth.APIToken, error)) *MockDatabase_ListUserAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsers provides a mock function with given fields: _a0
+func (_m *MockDatabase) ListUsers(_a0 context.Context) ([]*auth.User, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []*auth.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*auth.User, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*auth.User); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*auth.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockDatabase_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockDatabase_Expecter) ListUsers(_a0 interface{}) *MockDatabase_ListUsers_Call {
+	return &MockDatabase_ListUsers_Call{Call: _e.mock.On("ListUsers", _a0)}
+}
+
+func (_c *MockDatabase_ListUsers_Call) Run(run func(_a0 context.Context)) *MockDatabase_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ListUsers_Call) Return(_a0 []*auth.User, _a1 error) *MockDatabase_ListUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_ListUsers_Call) RunAndReturn(run func(context.Context) ([]*auth.User, error)) *MockDatabase_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ping provides a mock function with given fields: _a0
+func (_m *MockDatabase) Ping(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockDatabase_Ping_Call struct {
+	*mock.Call
+}
+
+// Ping is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockDatabase_Expecter) Ping(_a0 interface{}) *MockDatabase_Ping_Call {
+	return &MockDatabase_Ping_Call{Call: _e.mock.On("Ping", _a0)}
+}
+
+func (_c *MockDatabase_Ping_Call) Run(run func(_a0 context.Context)) *MockDatabase_Ping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_Ping_Call) Return(_a0 error) *MockDatabase_Ping_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_Ping_Call) RunAndReturn(run func(context.Context) error) *MockDatabase_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreServer provides a mock function with given fields: ctx, id, config, revision
+func (_m *MockDatabase) RestoreServer(ctx context.Context, id uuid.UUID, config server.ServerInstanceConfig, revision int64) error {
+	ret := _m.Called(ctx, id, config, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreServer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, server.ServerInstanceConfig, int64) error); ok {
+		r0 = rf(ctx, id, config, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_RestoreServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreServer'
+type MockDatabase_RestoreServer_Call struct {
+	*mock.Call
+}
+
+// RestoreServer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - config server.ServerInstanceConfig
+//   - revision int64
+func (_e *MockDatabase_Expecter) RestoreServer(ctx interface{}, id interface{}, config interface{}, revision interface{}) *MockDatabase_RestoreServer_Call {
+	return &MockDatabase_RestoreServer_Call{Call: _e.mock.On("RestoreServer", ctx, id, config, revision)}
+}
+
+func (_c *MockDatabase_RestoreServer_Call) Run(run func(ctx context.Context, id uuid.UUID, config server.ServerInstanceConfig, revision int64)) *MockDatabase_RestoreServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(server.ServerInstanceConfig), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_RestoreServer_Call) Return(_a0 error) *MockDatabase_RestoreServer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_RestoreServer_Call) RunAndReturn(run func(context.Context, uuid.UUID, server.ServerInstanceConfig, int64) error) *MockDatabase_RestoreServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIToken provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) RevokeAPIToken(_a0 context.Context, _a1 uuid.UUID) (*auth.APIToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIToken")
+	}
+
+	var r0 *auth.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*auth.APIToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *auth.APIToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
+type MockDatabase_RevokeAPIToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) RevokeAPIToken(_a0 interface{}, _a1 interface{}) *MockDatabase_RevokeAPIToken_Call {
+	return &MockDatabase_RevokeAPIToken_Call{Call: _e.mock.On("RevokeAPIToken", _a0, _a1)}
+}
+
+func (_c *MockDatabase_RevokeAPIToken_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_RevokeAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_RevokeAPIToken_Call) Return(_a0 *auth.APIToken, _a1 error) *MockDatabase_RevokeAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_RevokeAPIToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*auth.APIToken, error)) *MockDatabase_RevokeAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetServerGrant provides a mock function with given fields: ctx, userID, serverID, role
+func (_m *MockDatabase) SetServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role) error {
+	ret := _m.Called(ctx, userID, serverID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetServerGrant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, auth.Role) error); ok {
+		r0 = rf(ctx, userID, serverID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_SetServerGrant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetServerGrant'
+type MockDatabase_SetServerGrant_Call struct {
+	*mock.Call
+}
+
+// SetServerGrant is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - serverID uuid.UUID
+//   - role auth.Role
+func (_e *MockDatabase_Expecter) SetServerGrant(ctx interface{}, userID interface{}, serverID interface{}, role interface{}) *MockDatabase_SetServerGrant_Call {
+	return &MockDatabase_SetServerGrant_Call{Call: _e.mock.On("SetServerGrant", ctx, userID, serverID, role)}
+}
+
+func (_c *MockDatabase_SetServerGrant_Call) Run(run func(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role)) *MockDatabase_SetServerGrant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(auth.Role))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_SetServerGrant_Call) Return(_a0 error) *MockDatabase_SetServerGrant_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_SetServerGrant_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, auth.Role) error) *MockDatabase_SetServerGrant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchAPIToken provides a mock function with given fields: _a0, _a1
+func (_m *MockDatabase) TouchAPIToken(_a0 context.Context, _a1 uuid.UUID) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchAPIToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_TouchAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchAPIToken'
+type MockDatabase_TouchAPIToken_Call struct {
+	*mock.Call
+}
+
+// TouchAPIToken is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+func (_e *MockDatabase_Expecter) TouchAPIToken(_a0 interface{}, _a1 interface{}) *MockDatabase_TouchAPIToken_Call {
+	return &MockDatabase_TouchAPIToken_Call{Call: _e.mock.On("TouchAPIToken", _a0, _a1)}
+}
+
+func (_c *MockDatabase_TouchAPIToken_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID)) *MockDatabase_TouchAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_TouchAPIToken_Call) Return(_a0 error) *MockDatabase_TouchAPIToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_TouchAPIToken_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockDatabase_TouchAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateServer provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MockDatabase) UpdateServer(_a0 context.Context, _a1 uuid.UUID, _a2 server.ServerInstanceConfig) (server.ServerInstanceConfig, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateServer")
+	}
+
+	var r0 server.ServerInstanceConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, server.ServerInstanceConfig) (server.ServerInstanceConfig, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, server.ServerInstanceConfig) server.ServerInstanceConfig); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(server.ServerInstanceConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, server.ServerInstanceConfig) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_UpdateServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateServer'
+type MockDatabase_UpdateServer_Call struct {
+	*mock.Call
+}
+
+// UpdateServer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 uuid.UUID
+//   - _a2 server.ServerInstanceConfig
+func (_e *MockDatabase_Expecter) UpdateServer(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockDatabase_UpdateServer_Call {
+	return &MockDatabase_UpdateServer_Call{Call: _e.mock.On("UpdateServer", _a0, _a1, _a2)}
+}
+
+func (_c *MockDatabase_UpdateServer_Call) Run(run func(_a0 context.Context, _a1 uuid.UUID, _a2 server.ServerInstanceConfig)) *MockDatabase_UpdateServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(server.ServerInstanceConfig))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_UpdateServer_Call) Return(_a0 server.ServerInstanceConfig, _a1 error) *MockDatabase_UpdateServer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_UpdateServer_Call) RunAndReturn(run func(context.Context, uuid.UUID, server.ServerInstanceConfig) (server.ServerInstanceConfig, error)) *MockDatabase_UpdateServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatabase creates a new instance of MockDatabase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatabase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatabase {
+	mock := &MockDatabase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"context"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// authorize checks the caller holds the permission on the server. Servers the
+// caller can't see are reported as not found, so their existence isn't leaked.
+func authorize(ctx context.Context, serverID uuid.UUID, permission auth.Permission) error {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return errors.WithStack(auth.ErrForbidden)
+	}
+
+	switch {
+	case user.Can(serverID, permission):
+		return nil
+	case !user.Can(serverID, auth.PermissionView):
+		return errors.Wrapf(server.ErrInstanceNotFound, "instance of ID \"%s\"", serverID)
+	default:
+		return errors.Wrapf(auth.ErrForbidden, "missing %s permission", permission)
+	}
+}
+
+// authorizeAdmin checks the caller is an admin.
+func authorizeAdmin(ctx context.Context) error {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok || !user.IsAdmin {
+		return errors.WithStack(auth.ErrForbidden)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/domain/usecases"
+	"oppossome/serverpouch/internal/infrastructure/docker"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServerPermissions(t *testing.T) {
+	cfg := docker.DockerServerInstanceOptions{
+		Image:            "test",
+		ContainerVolumes: map[string]string{},
+		ContainerPorts:   map[int]string{},
+		ContainerEnv:     []string{},
+	}
+	oaCfg, err := openapi.ConfigToOAPI(&cfg)
+	assert.NoError(t, err)
+
+	t.Run("403 - Only admins create servers", func(t *testing.T) {
+		_, _, testServer := NewTestServerAs(t, &auth.User{ID: uuid.New(), Name: "user"})
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Post("%s/api/servers", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+
+	t.Run("404 - Servers without a grant are hidden", func(t *testing.T) {
+		_, _, testServer := NewTestServerAs(t, &auth.User{ID: uuid.New(), Name: "user"})
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s", testServer.URL, uuid.New()),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+
+	t.Run("200 - Only granted servers are listed", func(t *testing.T) {
+		user := &auth.User{ID: uuid.New(), Name: "user"}
+		_, mockUsecases, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().ListServers(mock.Anything, mock.MatchedBy(func(opts usecases.ListServersOptions) bool {
+			return opts.User == user
+		})).Return([]server.ServerInstance{}, "", nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusOK),
+		)
+	})
+
+	t.Run("200 - Moderators may restart", func(t *testing.T) {
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: "test"})
+		inst.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
+
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "moderator",
+			Grants: map[uuid.UUID]auth.Role{inst.Config().ID(): auth.RoleModerator},
+		}
+		_, mockUsecases, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().RestartServer(mock.Anything, inst.Config().ID()).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/restart", testServer.URL, inst.Config().ID()),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusOK),
+		)
+	})
+
+	t.Run("403 - Moderators may not reconfigure", func(t *testing.T) {
+		id := uuid.New()
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "moderator",
+			Grants: map[uuid.UUID]auth.Role{id: auth.RoleModerator},
+		}
+		_, _, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+
+	t.Run("200 - Managers may reconfigure", func(t *testing.T) {
+		current := cfg
+		current.InstanceID = uuid.New()
+		current.ContainerEnv = []string{"EULA=false"}
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(&current)
+		inst.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
+
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "manager",
+			Grants: map[uuid.UUID]auth.Role{current.InstanceID: auth.RoleManager},
+		}
+		_, mockUsecases, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, current.InstanceID).Return(inst, nil)
+		mockUsecases.EXPECT().UpdateServer(mock.Anything, current.InstanceID, mock.Anything).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, current.InstanceID),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`"0"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusOK),
+		)
+	})
+
+	t.Run("403 - Managers may not change volumes", func(t *testing.T) {
+		id := uuid.New()
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: id, Image: "test"})
+
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "manager",
+			Grants: map[uuid.UUID]auth.Role{id: auth.RoleManager},
+		}
+		_, mockUsecases, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, id).Return(inst, nil)
+
+		volumesCfg, err := openapi.ConfigToOAPI(&docker.DockerServerInstanceOptions{
+			Image:            "test",
+			ContainerVolumes: map[string]string{"/": "/host"},
+			ContainerEnv:     []string{},
+		})
+		assert.NoError(t, err)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`"0"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *volumesCfg}),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+
+	t.Run("204 - Managers may delete", func(t *testing.T) {
+		id := uuid.New()
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "manager",
+			Grants: map[uuid.UUID]auth.Role{id: auth.RoleManager},
+		}
+		_, mockUsecases, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().DeleteServer(mock.Anything, id, false).Return(nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/servers/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNoContent),
+		)
+	})
+
+	t.Run("403 - Managers may not purge data", func(t *testing.T) {
+		id := uuid.New()
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "manager",
+			Grants: map[uuid.UUID]auth.Role{id: auth.RoleManager},
+		}
+		_, _, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Delete("%s/api/servers/%s?purgeData=true", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+
+	t.Run("403 - Viewers may not start", func(t *testing.T) {
+		id := uuid.New()
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "viewer",
+			Grants: map[uuid.UUID]auth.Role{id: auth.RoleViewer},
+		}
+		_, _, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/start", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+}
@@ -0,0 +1,165 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	consolePingInterval = 30 * time.Second
+
+	// consolePongTimeout is how long a client may go without answering a
+	// ping, or sending anything else, before it's considered gone.
+	consolePongTimeout = 2 * consolePingInterval
+
+	// consoleWriteTimeout bounds how long a single write to a client may take.
+	consoleWriteTimeout = 10 * time.Second
+
+	// consoleBuffer is how many lines a client can fall behind by before
+	// further lines are dropped.
+	consoleBuffer = 1024
+
+	// consoleReadLimit is the largest message accepted as input, which is
+	// far more than any command needs. Larger ones close the connection.
+	consoleReadLimit = 32 * 1024
+)
+
+var consoleUpgrader = websocket.Upgrader{}
+
+// Open an interactive console to a server
+// (GET /api/servers/{id}/console)
+func (hi *httpImpl) ServerConsole(ctx context.Context, request openapi.ServerConsoleRequestObject) (openapi.ServerConsoleResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionConsole); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.GetServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server")
+	}
+
+	return serverConsoleResponse{
+		ctx:     ctx,
+		request: openapi.RequestFromContext(ctx),
+		inst:    inst,
+	}, nil
+}
+
+// Get a server's recent console output
+// (GET /api/servers/{id}/console/history)
+func (hi *httpImpl) GetConsoleHistory(ctx context.Context, request openapi.GetConsoleHistoryRequestObject) (openapi.GetConsoleHistoryResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionConsole); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.GetServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server")
+	}
+
+	var since uint64
+	if request.Params.Since != nil {
+		since = uint64(*request.Params.Since)
+	}
+
+	lines, truncated := inst.Events().ConsoleHistory.Since(since)
+
+	response := openapi.ConsoleHistoryResponse{
+		Lines:     make([]openapi.ConsoleLine, len(lines)),
+		Truncated: truncated,
+	}
+	for idx, line := range lines {
+		response.Lines[idx] = openapi.ConsoleLine{
+			Seq:       int64(line.Seq),
+			Timestamp: line.Time,
+			Text:      line.Text,
+		}
+	}
+
+	return openapi.GetConsoleHistory200JSONResponse(response), nil
+}
+
+// serverConsoleResponse upgrades the connection to a WebSocket and bridges it
+// with the instance's terminal events until either side goes away.
+type serverConsoleResponse struct {
+	ctx     context.Context
+	request *http.Request
+	inst    server.ServerInstance
+}
+
+func (scr serverConsoleResponse) VisitServerConsoleResponse(w http.ResponseWriter) error {
+	conn, err := consoleUpgrader.Upgrade(w, scr.request, nil)
+	if err != nil {
+		// The upgrader has already responded with an appropriate error.
+		zerolog.Ctx(scr.ctx).Err(err).Msg("failed to upgrade console connection")
+		return nil
+	}
+	defer conn.Close()
+	conn.SetReadLimit(consoleReadLimit)
+
+	ctx, ctxCancel := context.WithCancel(scr.ctx)
+	defer ctxCancel()
+
+	events := scr.inst.Events()
+	termOut := relayEvents(ctx, events.TerminalOut, consoleBuffer, func(string) {
+		zerolog.Ctx(ctx).Warn().Msg("dropped console output for slow client")
+	})
+
+	// Clients that stop answering pings are hung up on.
+	conn.SetReadDeadline(time.Now().Add(consolePongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(consolePongTimeout))
+	})
+
+	// Forward everything the client sends into the instance's stdin.
+	readerDone := make(chan struct{})
+	go func() {
+		defer close(readerDone)
+
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			conn.SetReadDeadline(time.Now().Add(consolePongTimeout))
+			events.TerminalIn.Dispatch(string(msg))
+		}
+	}()
+
+	pingTicker := time.NewTicker(consolePingInterval)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case <-readerDone:
+			return nil
+
+		case <-pingTicker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(consolePingInterval)); err != nil {
+				return nil
+			}
+
+		case line, ok := <-termOut:
+			if !ok {
+				msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server instance closed")
+				conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+				return nil
+			}
+
+			conn.SetWriteDeadline(time.Now().Add(consoleWriteTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(line)); err != nil {
+				return nil
+			}
+		}
+	}
+}
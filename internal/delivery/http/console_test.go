@@ -0,0 +1,165 @@
+package http_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServerConsole(t *testing.T) {
+	t.Run("101 - Bridges the terminal", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		events := server.NewServerInstanceEvents()
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Events().Return(events)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		termIn := events.TerminalIn.On()
+		defer events.TerminalIn.Off(termIn)
+
+		wsURL := "ws" + strings.TrimPrefix(testServer.URL, "http") + "/api/servers/" + uuid.Nil.String() + "/console"
+		conn, resp, err := websocket.DefaultDialer.Dial(wsURL, authHeader())
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+		defer conn.Close()
+
+		// Messages from the client are forwarded to the terminal's input
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("help")))
+		select {
+		case msg := <-termIn:
+			assert.Equal(t, "help", msg)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Terminal input timed out")
+		}
+
+		// The terminal's output is forwarded to the client
+		go events.TerminalOut.Dispatch("Hello, World!")
+		_, msg, err := conn.ReadMessage()
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello, World!", string(msg))
+	})
+
+	t.Run("101 - Closes on oversized input", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		events := server.NewServerInstanceEvents()
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Events().Return(events)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		wsURL := "ws" + strings.TrimPrefix(testServer.URL, "http") + "/api/servers/" + uuid.Nil.String() + "/console"
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, authHeader())
+		assert.NoError(t, err)
+		defer conn.Close()
+
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(strings.Repeat("a", 64*1024))))
+
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig), "unexpected error %v", err)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(nil, errors.WithStack(server.ErrInstanceNotFound))
+
+		wsURL := "ws" + strings.TrimPrefix(testServer.URL, "http") + "/api/servers/" + uuid.Nil.String() + "/console"
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL, authHeader())
+		assert.ErrorIs(t, err, websocket.ErrBadHandshake)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestGetConsoleHistory(t *testing.T) {
+	t.Run("200 - Lines since", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		events := server.NewServerInstanceEvents()
+		events.ConsoleHistory.Append("first")
+		events.ConsoleHistory.Append("second")
+		events.ConsoleHistory.Append("third")
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Events().Return(events)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/console/history?since=1", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".lines | map(.seq)").Equal([]int{2, 3}),
+			hit.Expect().Body().JSON().JQ(".lines | map(.text)").Equal([]string{"second", "third"}),
+			hit.Expect().Body().JSON().JQ(".truncated").Equal(false),
+		)
+	})
+
+	t.Run("200 - Records output dispatched without listeners", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		events := server.NewServerInstanceEvents()
+		events.TerminalOut.Dispatch("Pulling image")
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Events().Return(events)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		assert.Eventually(t, func() bool {
+			lines, _ := events.ConsoleHistory.Since(0)
+			return len(lines) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/console/history", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".lines[0].text").Equal("Pulling image"),
+		)
+	})
+
+	t.Run("400 - Invalid since", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/console/history?since=-1", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("since"),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(nil, errors.WithStack(server.ErrInstanceNotFound))
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/console/history", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+}
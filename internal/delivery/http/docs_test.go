@@ -0,0 +1,55 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Eun/go-hit"
+)
+
+func TestDocs(t *testing.T) {
+	// The docs are public, so these are requested without a token.
+	t.Run("200 - JSON spec", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/openapi.json", testServer.URL),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("Content-Type").Equal("application/json"),
+			hit.Expect().Body().JSON().JQ(".info.title").Equal("Serverpouch API"),
+			hit.Expect().Body().JSON().JQ(".paths[\"/api/servers\"].get.operationId").Equal("ListServers"),
+		)
+	})
+
+	t.Run("200 - YAML spec", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/openapi.yaml", testServer.URL),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("Content-Type").Equal("application/yaml"),
+			hit.Expect().Body().String().Contains("operationId: \"ListServers\""),
+		)
+	})
+
+	t.Run("200 - Explorer", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/docs", testServer.URL),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("Content-Type").Equal("text/html; charset=utf-8"),
+			hit.Expect().Body().String().Contains("fetch(\"openapi.json\")"),
+		)
+	})
+
+	t.Run("404 - Unknown route", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/nothing", testServer.URL),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+			hit.Expect().Headers("Content-Type").Equal("application/problem+json"),
+		)
+	})
+}
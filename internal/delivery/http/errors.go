@@ -0,0 +1,92 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/domain/usecases"
+
+	"github.com/pkg/errors"
+)
+
+// errPreconditionRequired is returned when a conditional request is made
+// without a precondition.
+var errPreconditionRequired = errors.New("precondition required")
+
+// invalidParamError is returned when a parameter matches the spec, but still
+// can't be used.
+type invalidParamError struct {
+	Param  string
+	Reason string
+}
+
+func (e *invalidParamError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Param, e.Reason)
+}
+
+// problemFromError maps the errors returned by handlers onto problems,
+// keeping the details of unexpected errors out of the response.
+func problemFromError(err error) openapi.Error {
+	var statusErr *server.InvalidStatusError
+	var configErr *server.InvalidConfigError
+	var paramErr *invalidParamError
+	var pathErr *server.InvalidPathError
+
+	switch {
+	case errors.Is(err, server.ErrInstanceNotFound):
+		return openapi.NewError(http.StatusNotFound, "not-found", "Server not found")
+	case errors.Is(err, auth.ErrTokenNotFound):
+		return openapi.NewError(http.StatusNotFound, "not-found", "Token not found")
+	case errors.Is(err, auth.ErrUserNotFound):
+		return openapi.NewError(http.StatusNotFound, "not-found", "User not found")
+	case errors.Is(err, server.ErrFileNotFound):
+		return openapi.NewError(http.StatusNotFound, "not-found", "File not found")
+
+	case errors.Is(err, auth.ErrForbidden):
+		return openapi.NewError(http.StatusForbidden, "forbidden", "You don't have permission to do this")
+
+	case errors.Is(err, auth.ErrUserExists):
+		return openapi.NewError(http.StatusConflict, "conflict", "A user with this name already exists")
+	case errors.Is(err, server.ErrFileExists):
+		return openapi.NewError(http.StatusConflict, "conflict", "A file already exists at this path")
+	case errors.Is(err, server.ErrDirectoryNotEmpty):
+		return openapi.NewError(http.StatusConflict, "conflict", "The directory isn't empty")
+
+	case errors.Is(err, server.ErrRevisionMismatch):
+		return openapi.NewError(http.StatusPreconditionFailed, "precondition-failed", "The server has been modified since it was read")
+	case errors.Is(err, errPreconditionRequired):
+		return openapi.NewError(http.StatusPreconditionRequired, "precondition-required", "Updates must be made against a revision, by passing its ETag as If-Match")
+
+	case errors.As(err, &statusErr):
+		return openapi.NewError(http.StatusConflict, "invalid-status", statusErr.Error())
+
+	case errors.As(err, &configErr):
+		problem := openapi.NewError(http.StatusBadRequest, "invalid-config", "The server config is invalid")
+		problem.Errors = &[]openapi.FieldError{{Field: configErr.Field, Message: configErr.Reason}}
+		return problem
+
+	case errors.As(err, &pathErr):
+		return openapi.NewError(http.StatusBadRequest, "invalid-path", pathErr.Error())
+
+	case errors.As(err, &paramErr):
+		problem := openapi.NewError(http.StatusBadRequest, "validation", "The request is invalid")
+		problem.Errors = &[]openapi.FieldError{{Field: paramErr.Param, Message: paramErr.Reason}}
+		return problem
+
+	case errors.Is(err, auth.ErrInvalidRole):
+		problem := openapi.NewError(http.StatusBadRequest, "validation", "The request is invalid")
+		problem.Errors = &[]openapi.FieldError{{Field: "role", Message: "Unknown role"}}
+		return problem
+
+	case errors.Is(err, usecases.ErrInvalidCursor):
+		problem := openapi.NewError(http.StatusBadRequest, "validation", "The request is invalid")
+		problem.Errors = &[]openapi.FieldError{{Field: "cursor", Message: "Invalid cursor"}}
+		return problem
+
+	default:
+		return openapi.NewError(http.StatusInternalServerError, "internal", "An unexpected error occurred")
+	}
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"oppossome/serverpouch/internal/common/events"
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/usecases"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	eventsKeepAliveInterval = 30 * time.Second
+
+	// eventsWriteTimeout bounds how long a single write to a client may take.
+	eventsWriteTimeout = 10 * time.Second
+
+	// eventsBuffer is how many events a client can fall behind by before
+	// further events are dropped.
+	eventsBuffer = 64
+)
+
+// Stream status changes across all servers
+// (GET /api/events)
+func (hi *httpImpl) ServerEvents(ctx context.Context, request openapi.ServerEventsRequestObject) (openapi.ServerEventsResponseObject, error) {
+	return serverEventsResponse{
+		ctx:          ctx,
+		statusEvents: hi.usecases.StatusEvents(),
+	}, nil
+}
+
+// serverEventsResponse writes status changes as Server-Sent Events until the
+// client disconnects or the usecases are closed.
+type serverEventsResponse struct {
+	ctx          context.Context
+	statusEvents events.EventEmitter[usecases.ServerStatusEvent]
+}
+
+func (ser serverEventsResponse) VisitServerEventsResponse(w http.ResponseWriter) error {
+	statusChan := relayStatusEvents(ser.ctx, ser.statusEvents)
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		zerolog.Ctx(ser.ctx).Err(err).Msg("failed to flush event stream")
+		return nil
+	}
+
+	keepAliveTicker := time.NewTicker(eventsKeepAliveInterval)
+	defer keepAliveTicker.Stop()
+
+	for {
+		var payload string
+
+		select {
+		case <-ser.ctx.Done():
+			return nil
+
+		case <-keepAliveTicker.C:
+			payload = ": keep-alive\n\n"
+
+		case event, ok := <-statusChan:
+			if !ok {
+				return nil
+			}
+
+			// Grants are taken from when the stream was opened.
+			if authorize(ser.ctx, event.ID, auth.PermissionView) != nil {
+				continue
+			}
+
+			data, err := json.Marshal(openapi.ServerStatusEvent{
+				Id:        event.ID,
+				OldStatus: openapi.ServerStatus(event.OldStatus),
+				NewStatus: openapi.ServerStatus(event.NewStatus),
+				Timestamp: event.Time,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to encode status event")
+			}
+
+			payload = fmt.Sprintf("event: status\ndata: %s\n\n", data)
+		}
+
+		// Not every writer supports deadlines, in which case we write without one.
+		rc.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+
+		if _, err := fmt.Fprint(w, payload); err != nil {
+			return nil
+		}
+
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+	}
+}
+
+// relayStatusEvents passes the status events on through a buffer, as servers
+// dispatch their status while locked.
+func relayStatusEvents(ctx context.Context, statusEvents events.EventEmitter[usecases.ServerStatusEvent]) <-chan usecases.ServerStatusEvent {
+	return relayEvents(ctx, statusEvents, eventsBuffer, func(event usecases.ServerStatusEvent) {
+		zerolog.Ctx(ctx).Warn().Str("id", event.ID.String()).Msg("dropped status event for slow client")
+	})
+}
+
+// relayEvents listens to the emitter until ctx is done, passing its events on
+// through a buffer of the given size. Dispatching waits on every listener, so
+// a slow client must never hold it up: events that don't fit are dropped, and
+// handed to dropped instead. The returned channel closes with the emitter.
+func relayEvents[O any](ctx context.Context, emitter events.EventEmitter[O], size int, dropped func(O)) <-chan O {
+	listener := emitter.On()
+	relayChan := make(chan O, size)
+
+	go func() {
+		<-ctx.Done()
+		emitter.Off(listener)
+	}()
+
+	go func() {
+		defer close(relayChan)
+
+		for event := range listener {
+			select {
+			case relayChan <- event:
+			default:
+				dropped(event)
+			}
+		}
+	}()
+
+	return relayChan
+}
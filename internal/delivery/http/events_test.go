@@ -0,0 +1,64 @@
+package http_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/common/events"
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/domain/usecases"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerEvents(t *testing.T) {
+	t.Run("200 - Streams status changes", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		// Setup mock expectations
+		statusEvents := events.New[usecases.ServerStatusEvent]("server_status")
+		mockUsecases.EXPECT().StatusEvents().Return(statusEvents)
+
+		resp, err := testClient.Get(testServer.URL + "/api/events")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		event := usecases.ServerStatusEvent{
+			ID:        uuid.New(),
+			OldStatus: server.ServerInstanceStatusStarting,
+			NewStatus: server.ServerInstanceStatusRunning,
+			Time:      time.Now().UTC().Truncate(time.Second),
+		}
+
+		// The listener is registered once the response starts streaming
+		go statusEvents.Dispatch(event)
+
+		reader := bufio.NewReader(resp.Body)
+		eventLine, err := reader.ReadString('\n')
+		assert.NoError(t, err)
+		assert.Equal(t, "event: status\n", eventLine)
+
+		dataLine, err := reader.ReadString('\n')
+		assert.NoError(t, err)
+
+		var oEvent openapi.ServerStatusEvent
+		err = json.Unmarshal([]byte(strings.TrimPrefix(dataLine, "data: ")), &oEvent)
+		assert.NoError(t, err)
+		assert.Equal(t, openapi.ServerStatusEvent{
+			Id:        event.ID,
+			OldStatus: openapi.Starting,
+			NewStatus: openapi.Running,
+			Timestamp: event.Time,
+		}, oEvent)
+	})
+}
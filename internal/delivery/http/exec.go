@@ -0,0 +1,169 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// execOutputLimit bounds how much of each stream a captured command keeps.
+const execOutputLimit = 1 << 20
+
+// Commands run with the same access as the server itself, which is as much as
+// editing its files, so they need the same permission.
+
+// Run a command within a server
+// (POST /api/servers/{id}/exec)
+func (hi *httpImpl) ExecCommand(ctx context.Context, request openapi.ExecCommandRequestObject) (openapi.ExecCommandResponseObject, error) {
+	process, err := hi.execServer(ctx, request.Id, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer process.Close()
+
+	var stdout, stderr strings.Builder
+	// Once a stream is full, its later lines are dropped even if they'd fit,
+	// so that what's kept is all from the start.
+	full := map[server.LogStream]bool{}
+	for {
+		line, err := process.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "failed to read command output")
+		}
+
+		output := &stdout
+		if line.Stream == server.LogStreamStderr {
+			output = &stderr
+		}
+
+		if full[line.Stream] || output.Len()+len(line.Text)+1 > execOutputLimit {
+			full[line.Stream] = true
+			continue
+		}
+
+		output.WriteString(line.Text)
+		output.WriteByte('\n')
+	}
+
+	exitCode, err := process.Wait(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wait for command")
+	}
+
+	return openapi.ExecCommand200JSONResponse{
+		ExitCode:  exitCode,
+		Stdout:    stdout.String(),
+		Stderr:    stderr.String(),
+		Truncated: full[server.LogStreamStdout] || full[server.LogStreamStderr],
+	}, nil
+}
+
+// Run a command within a server, streaming its output
+// (POST /api/servers/{id}/exec/stream)
+func (hi *httpImpl) StreamExecCommand(ctx context.Context, request openapi.StreamExecCommandRequestObject) (openapi.StreamExecCommandResponseObject, error) {
+	process, err := hi.execServer(ctx, request.Id, *request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return streamExecResponse{ctx: ctx, process: process}, nil
+}
+
+// execServer authorizes the request and starts the command.
+func (hi *httpImpl) execServer(ctx context.Context, id uuid.UUID, newExec openapi.NewExec) (server.ExecProcess, error) {
+	if err := authorize(ctx, id, auth.PermissionConfigure); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.GetServer(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server")
+	}
+
+	opts := server.ExecOptions{Cmd: newExec.Command}
+	if newExec.Environment != nil {
+		opts.Env = *newExec.Environment
+	}
+	if newExec.WorkingDir != nil {
+		opts.WorkingDir = *newExec.WorkingDir
+	}
+	if newExec.User != nil {
+		opts.User = *newExec.User
+	}
+
+	process, err := inst.Exec(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run command")
+	}
+
+	return process, nil
+}
+
+// streamExecResponse streams the command's output as NDJSON, ending with its
+// exit code.
+type streamExecResponse struct {
+	ctx     context.Context
+	process server.ExecProcess
+}
+
+func (ser streamExecResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	defer ser.process.Close()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	writeLine := func(line openapi.ExecStreamLine) error {
+		if err := encoder.Encode(line); err != nil {
+			return err
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	for {
+		line, err := ser.process.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			// The response has already begun, so all we can do is end it early.
+			if ser.ctx.Err() == nil {
+				zerolog.Ctx(ser.ctx).Err(err).Msg("failed to read command output")
+			}
+			return nil
+		}
+
+		stream := openapi.LogStream(line.Stream)
+		if err := writeLine(openapi.ExecStreamLine{Stream: &stream, Text: &line.Text}); err != nil {
+			return nil
+		}
+	}
+
+	exitCode, err := ser.process.Wait(ser.ctx)
+	if err != nil {
+		if ser.ctx.Err() == nil {
+			zerolog.Ctx(ser.ctx).Err(err).Msg("failed to wait for command")
+		}
+		return nil
+	}
+
+	writeLine(openapi.ExecStreamLine{ExitCode: &exitCode})
+	return nil
+}
@@ -0,0 +1,116 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+// testExecProcess prints a fixed set of lines, then exits.
+type testExecProcess struct {
+	testLogReader
+	exitCode int
+}
+
+func (tep *testExecProcess) Wait(context.Context) (int, error) {
+	return tep.exitCode, nil
+}
+
+func TestExecCommand(t *testing.T) {
+	t.Run("200 - Captures output", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Exec(mock.Anything, server.ExecOptions{
+			Cmd:        []string{"sh", "-c", "ls"},
+			Env:        []string{"LANG=C"},
+			WorkingDir: "/data",
+		}).Return(&testExecProcess{testLogReader: testLogReader{lines: testLogLines}, exitCode: 2}, nil)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/exec", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]any{
+				"command":     []string{"sh", "-c", "ls"},
+				"environment": []string{"LANG=C"},
+				"workingDir":  "/data",
+			}),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".exitCode").Equal(2),
+			hit.Expect().Body().JSON().JQ(".stdout").Equal("Hello\n"),
+			hit.Expect().Body().JSON().JQ(".stderr").Equal("Oops\n"),
+			hit.Expect().Body().JSON().JQ(".truncated").Equal(false),
+		)
+	})
+
+	t.Run("400 - Empty command", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/exec", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]any{"command": []string{}}),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+		)
+	})
+
+	t.Run("409 - Not running", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Exec(mock.Anything, mock.Anything).
+			Return(nil, errors.WithStack(&server.InvalidStatusError{Action: "Exec", Status: server.ServerInstanceStatusIdle}))
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/exec", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]any{"command": []string{"true"}}),
+			hit.Expect().Status().Equal(http.StatusConflict),
+		)
+	})
+}
+
+func TestStreamExecCommand(t *testing.T) {
+	t.Run("200 - Streams output then the exit code", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Exec(mock.Anything, server.ExecOptions{Cmd: []string{"ls"}}).
+			Return(&testExecProcess{testLogReader: testLogReader{lines: testLogLines}}, nil)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/exec/stream", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]any{"command": []string{"ls"}}),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("Content-Type").Equal("application/x-ndjson"),
+			hit.Expect().Body().String().Equal(
+				"{\"stream\":\"stdout\",\"text\":\"Hello\"}\n"+
+					"{\"stream\":\"stderr\",\"text\":\"Oops\"}\n"+
+					"{\"exitCode\":0}\n",
+			),
+		)
+	})
+}
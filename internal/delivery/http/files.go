@@ -0,0 +1,143 @@
+package http
+
+import (
+	"context"
+	"mime"
+	"path"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Files can be read by anyone who can read the console, since it shows much
+// the same, but only changed by those who can configure the server.
+
+// List a directory within the server's volumes
+// (GET /api/servers/{id}/files)
+func (hi *httpImpl) ListFiles(ctx context.Context, request openapi.ListFilesRequestObject) (openapi.ListFilesResponseObject, error) {
+	files, err := hi.serverFiles(ctx, request.Id, auth.PermissionConsole)
+	if err != nil {
+		return nil, err
+	}
+
+	dirPath := ""
+	if request.Params.Path != nil {
+		dirPath = *request.Params.Path
+	}
+
+	infos, err := files.List(ctx, dirPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list files")
+	}
+
+	oFiles := make([]openapi.FileInfo, 0, len(infos))
+	for _, info := range infos {
+		oFiles = append(oFiles, openapi.FileToOAPI(info))
+	}
+
+	return openapi.ListFiles200JSONResponse{Files: oFiles}, nil
+}
+
+// Download a file from the server's volumes
+// (GET /api/servers/{id}/files/content)
+func (hi *httpImpl) DownloadFile(ctx context.Context, request openapi.DownloadFileRequestObject) (openapi.DownloadFileResponseObject, error) {
+	files, err := hi.serverFiles(ctx, request.Id, auth.PermissionConsole)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, info, err := files.Open(ctx, request.Params.Path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+
+	return openapi.DownloadFile200ApplicationoctetStreamResponse{
+		Body: contents,
+		Headers: openapi.DownloadFile200ResponseHeaders{
+			ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": path.Base(info.Path)}),
+		},
+		ContentLength: info.Size,
+	}, nil
+}
+
+// Upload a file to the server's volumes
+// (PUT /api/servers/{id}/files/content)
+func (hi *httpImpl) UploadFile(ctx context.Context, request openapi.UploadFileRequestObject) (openapi.UploadFileResponseObject, error) {
+	files, err := hi.serverFiles(ctx, request.Id, auth.PermissionConfigure)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := files.Write(ctx, request.Params.Path, request.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write file")
+	}
+
+	return openapi.UploadFile200JSONResponse{File: openapi.FileToOAPI(info)}, nil
+}
+
+// Create a directory within the server's volumes
+// (POST /api/servers/{id}/files/directories)
+func (hi *httpImpl) CreateDirectory(ctx context.Context, request openapi.CreateDirectoryRequestObject) (openapi.CreateDirectoryResponseObject, error) {
+	files, err := hi.serverFiles(ctx, request.Id, auth.PermissionConfigure)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := files.Mkdir(ctx, request.Body.Path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create directory")
+	}
+
+	return openapi.CreateDirectory201JSONResponse{File: openapi.FileToOAPI(info)}, nil
+}
+
+// Move or rename a file within the server's volumes
+// (POST /api/servers/{id}/files/rename)
+func (hi *httpImpl) RenameFile(ctx context.Context, request openapi.RenameFileRequestObject) (openapi.RenameFileResponseObject, error) {
+	files, err := hi.serverFiles(ctx, request.Id, auth.PermissionConfigure)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := files.Rename(ctx, request.Body.From, request.Body.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to rename file")
+	}
+
+	return openapi.RenameFile200JSONResponse{File: openapi.FileToOAPI(info)}, nil
+}
+
+// Delete a file from the server's volumes
+// (DELETE /api/servers/{id}/files)
+func (hi *httpImpl) DeleteFile(ctx context.Context, request openapi.DeleteFileRequestObject) (openapi.DeleteFileResponseObject, error) {
+	files, err := hi.serverFiles(ctx, request.Id, auth.PermissionConfigure)
+	if err != nil {
+		return nil, err
+	}
+
+	recursive := request.Params.Recursive != nil && *request.Params.Recursive
+	if err := files.Remove(ctx, request.Params.Path, recursive); err != nil {
+		return nil, errors.Wrap(err, "failed to delete file")
+	}
+
+	return openapi.DeleteFile204Response{}, nil
+}
+
+// serverFiles authorizes the request and returns the server's files.
+func (hi *httpImpl) serverFiles(ctx context.Context, id uuid.UUID, permission auth.Permission) (server.Files, error) {
+	if err := authorize(ctx, id, permission); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.GetServer(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server")
+	}
+
+	return inst.Files(), nil
+}
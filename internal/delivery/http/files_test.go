@@ -0,0 +1,279 @@
+package http_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+var testFileInfo = server.FileInfo{
+	Path:    "/data/server.properties",
+	Type:    server.FileTypeFile,
+	Size:    12,
+	ModTime: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+}
+
+func TestListFiles(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().List(mock.Anything, "/data").Return([]server.FileInfo{testFileInfo}, nil)
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/files?path=/data", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".files[0].path").Equal("/data/server.properties"),
+			hit.Expect().Body().JSON().JQ(".files[0].type").Equal("file"),
+			hit.Expect().Body().JSON().JQ(".files[0].size").Equal(12),
+		)
+	})
+
+	t.Run("400 - Invalid path", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().List(mock.Anything, "/etc").Return(nil, &server.InvalidPathError{Path: "/etc", Reason: "not within any of the server's volumes"})
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/files?path=/etc", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".type").Contains("invalid-path"),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().List(mock.Anything, "/data/world").Return(nil, errors.WithStack(server.ErrFileNotFound))
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/files?path=/data/world", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+}
+
+func TestDownloadFile(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().Open(mock.Anything, "/data/server.properties").
+			Return(io.NopCloser(strings.NewReader("motd=Welcome")), testFileInfo, nil)
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/files/content?path=/data/server.properties", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("Content-Type").Equal("application/octet-stream"),
+			hit.Expect().Headers("Content-Disposition").Equal("attachment; filename=server.properties"),
+			hit.Expect().Body().String().Equal("motd=Welcome"),
+		)
+	})
+}
+
+func TestUploadFile(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// The mock prints the request body when asserting its expectations, so
+		// the server has to be done with it by then.
+		defer testServer.Close()
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().Write(mock.Anything, "/data/server.properties", mock.AnythingOfType("*http.body")).
+			RunAndReturn(func(_ context.Context, _ string, contents io.Reader) (server.FileInfo, error) {
+				body, err := io.ReadAll(contents)
+				if err != nil || string(body) != "motd=Welcome" {
+					return server.FileInfo{}, errors.Errorf("unexpected contents %q", body)
+				}
+
+				return testFileInfo, nil
+			})
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s/files/content?path=/data/server.properties", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/octet-stream"),
+			hit.Send().Body().String("motd=Welcome"),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".file.path").Equal("/data/server.properties"),
+		)
+	})
+
+	t.Run("403 - Viewers may not upload", func(t *testing.T) {
+		id := uuid.New()
+		user := &auth.User{
+			ID:     uuid.New(),
+			Name:   "viewer",
+			Grants: map[uuid.UUID]auth.Role{id: auth.RoleViewer},
+		}
+		_, _, testServer := NewTestServerAs(t, user)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s/files/content?path=/data/server.properties", testServer.URL, id),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/octet-stream"),
+			hit.Send().Body().String("motd=Welcome"),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+}
+
+func TestCreateDirectory(t *testing.T) {
+	t.Run("201 - Created", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().Mkdir(mock.Anything, "/data/plugins").
+			Return(server.FileInfo{Path: "/data/plugins", Type: server.FileTypeDirectory}, nil)
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/files/directories", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]string{"path": "/data/plugins"}),
+			hit.Expect().Status().Equal(http.StatusCreated),
+			hit.Expect().Body().JSON().JQ(".file.type").Equal("directory"),
+		)
+	})
+
+	t.Run("409 - Conflict", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().Mkdir(mock.Anything, "/data/plugins").Return(server.FileInfo{}, errors.WithStack(server.ErrFileExists))
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/files/directories", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]string{"path": "/data/plugins"}),
+			hit.Expect().Status().Equal(http.StatusConflict),
+		)
+	})
+}
+
+func TestRenameFile(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().Rename(mock.Anything, "/data/old.properties", "/data/server.properties").Return(testFileInfo, nil)
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/files/rename", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]string{"from": "/data/old.properties", "to": "/data/server.properties"}),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".file.path").Equal("/data/server.properties"),
+		)
+	})
+}
+
+func TestDeleteFile(t *testing.T) {
+	t.Run("204 - Recursive", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().Remove(mock.Anything, "/data/world", true).Return(nil)
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/servers/%s/files?path=/data/world&recursive=true", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusNoContent),
+		)
+	})
+
+	t.Run("409 - Not empty", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		files := mockServer.NewMockFiles(t)
+		files.EXPECT().Remove(mock.Anything, "/data/world", false).Return(errors.WithStack(server.ErrDirectoryNotEmpty))
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Files().Return(files)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/servers/%s/files?path=/data/world", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusConflict),
+		)
+	})
+}
@@ -0,0 +1,158 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"oppossome/serverpouch/internal/common/metrics"
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/infrastructure/database"
+
+	"github.com/docker/docker/client"
+	"github.com/go-chi/chi/v5"
+)
+
+// healthCheckTimeout bounds how long each dependency has to respond.
+const healthCheckTimeout = 2 * time.Second
+
+const (
+	healthStatusOK      = "ok"
+	healthStatusError   = "error"
+	healthStatusLoading = "loading"
+)
+
+type healthCheck struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type healthReport struct {
+	Status string                 `json:"status"`
+	Checks map[string]healthCheck `json:"checks,omitempty"`
+}
+
+// Root serves the health checks and metrics as soon as the daemon starts, and
+// the API once the usecases have loaded. Until then, API requests are turned
+// away.
+type Root struct {
+	chi.Router
+
+	db     database.Database
+	docker client.APIClient
+	api    atomic.Pointer[http.Handler]
+}
+
+func NewRoot(db database.Database, docker client.APIClient) *Root {
+	root := &Root{
+		Router: chi.NewRouter(),
+		db:     db,
+		docker: docker,
+	}
+
+	root.Get("/healthz", root.liveness)
+	root.Get("/readyz", root.readiness)
+	root.Handle("/metrics", metrics.Handler())
+	root.NotFound(root.serveAPI)
+	root.MethodNotAllowed(root.serveAPI)
+
+	return root
+}
+
+// SetAPI starts serving the API, marking the daemon as ready.
+func (root *Root) SetAPI(api http.Handler) {
+	root.api.Store(&api)
+}
+
+func (root *Root) serveAPI(w http.ResponseWriter, r *http.Request) {
+	api := root.api.Load()
+	if api == nil {
+		w.Header().Set("Retry-After", "5")
+		openapi.WriteError(w, r, openapi.NewError(http.StatusServiceUnavailable, "unavailable", "Serverpouch is still starting"))
+		return
+	}
+
+	(*api).ServeHTTP(w, r)
+}
+
+// liveness reports the daemon is up, regardless of its dependencies.
+func (root *Root) liveness(w http.ResponseWriter, r *http.Request) {
+	writeHealthReport(w, http.StatusOK, healthReport{Status: healthStatusOK})
+}
+
+// readiness checks every dependency, reporting unavailable if any of them
+// failed or the usecases are still loading.
+func (root *Root) readiness(w http.ResponseWriter, r *http.Request) {
+	checks := map[string]func(context.Context) error{
+		"database": root.db.Ping,
+		"docker": func(ctx context.Context) error {
+			_, err := root.docker.Ping(ctx)
+			return err
+		},
+	}
+
+	report := healthReport{Status: healthStatusOK, Checks: map[string]healthCheck{}}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result := runHealthCheck(r.Context(), check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+		}()
+	}
+	wg.Wait()
+
+	if root.api.Load() == nil {
+		report.Checks["usecases"] = healthCheck{Status: healthStatusLoading}
+	} else {
+		report.Checks["usecases"] = healthCheck{Status: healthStatusOK}
+	}
+
+	status := http.StatusOK
+	for _, check := range report.Checks {
+		if check.Status != healthStatusOK {
+			report.Status = "unavailable"
+			status = http.StatusServiceUnavailable
+		}
+	}
+
+	writeHealthReport(w, status, report)
+}
+
+func runHealthCheck(ctx context.Context, check func(context.Context) error) healthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	result := healthCheck{
+		Status:    healthStatusOK,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+
+	if err != nil {
+		result.Status = healthStatusError
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+func writeHealthReport(w http.ResponseWriter, status int, report healthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	json.NewEncoder(w).Encode(report)
+}
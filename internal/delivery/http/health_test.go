@@ -0,0 +1,139 @@
+package http_test
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"oppossome/serverpouch/internal/delivery/http"
+
+	mockDocker "oppossome/serverpouch/internal/common/test/mocks/github.com/docker/docker/client"
+	mockDatabase "oppossome/serverpouch/internal/common/test/mocks/infrastructure/database"
+
+	"github.com/Eun/go-hit"
+	"github.com/docker/docker/api/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+// NewTestRoot creates a test server for the root handler, whose API has not yet loaded.
+func NewTestRoot(t *testing.T) (*mockDatabase.MockDatabase, *mockDocker.MockAPIClient, *http.Root, *httptest.Server) {
+	mockDB := mockDatabase.NewMockDatabase(t)
+	mockDockerClient := mockDocker.NewMockAPIClient(t)
+
+	root := http.NewRoot(mockDB, mockDockerClient)
+	testServer := httptest.NewServer(root)
+	t.Cleanup(testServer.Close)
+
+	return mockDB, mockDockerClient, root, testServer
+}
+
+func TestHealthz(t *testing.T) {
+	t.Run("200 - While loading", func(t *testing.T) {
+		_, _, _, testServer := NewTestRoot(t)
+
+		hit.MustDo(
+			hit.Get("%s/healthz", testServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusOK),
+			hit.Expect().Body().JSON().JQ(".status").Equal("ok"),
+		)
+	})
+}
+
+func TestReadyz(t *testing.T) {
+	api := nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.WriteHeader(nethttp.StatusTeapot)
+	})
+
+	t.Run("200 - Ready", func(t *testing.T) {
+		mockDB, mockDockerClient, root, testServer := NewTestRoot(t)
+		root.SetAPI(api)
+
+		mockDB.EXPECT().Ping(mock.Anything).Return(nil)
+		mockDockerClient.EXPECT().Ping(mock.Anything).Return(types.Ping{}, nil)
+
+		hit.MustDo(
+			hit.Get("%s/readyz", testServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusOK),
+			hit.Expect().Body().JSON().JQ(".status").Equal("ok"),
+			hit.Expect().Body().JSON().JQ(".checks.database.status").Equal("ok"),
+			hit.Expect().Body().JSON().JQ(".checks.docker.status").Equal("ok"),
+			hit.Expect().Body().JSON().JQ(".checks.usecases.status").Equal("ok"),
+		)
+	})
+
+	t.Run("503 - Docker unavailable", func(t *testing.T) {
+		mockDB, mockDockerClient, root, testServer := NewTestRoot(t)
+		root.SetAPI(api)
+
+		mockDB.EXPECT().Ping(mock.Anything).Return(nil)
+		mockDockerClient.EXPECT().Ping(mock.Anything).Return(types.Ping{}, errors.New("daemon unreachable"))
+
+		hit.MustDo(
+			hit.Get("%s/readyz", testServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusServiceUnavailable),
+			hit.Expect().Body().JSON().JQ(".status").Equal("unavailable"),
+			hit.Expect().Body().JSON().JQ(".checks.database.status").Equal("ok"),
+			hit.Expect().Body().JSON().JQ(".checks.docker.status").Equal("error"),
+			hit.Expect().Body().JSON().JQ(".checks.docker.error").Equal("daemon unreachable"),
+		)
+	})
+
+	t.Run("503 - Loading", func(t *testing.T) {
+		mockDB, mockDockerClient, _, testServer := NewTestRoot(t)
+
+		mockDB.EXPECT().Ping(mock.Anything).Return(nil)
+		mockDockerClient.EXPECT().Ping(mock.Anything).Return(types.Ping{}, nil)
+
+		hit.MustDo(
+			hit.Get("%s/readyz", testServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusServiceUnavailable),
+			hit.Expect().Body().JSON().JQ(".checks.usecases.status").Equal("loading"),
+		)
+	})
+}
+
+func TestRootAPI(t *testing.T) {
+	t.Run("503 - Loading", func(t *testing.T) {
+		_, _, _, testServer := NewTestRoot(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusServiceUnavailable),
+			hit.Expect().Headers("Content-Type").Equal("application/problem+json"),
+			hit.Expect().Headers("Retry-After").Equal("5"),
+		)
+	})
+
+	t.Run("Serves the API once loaded", func(t *testing.T) {
+		_, _, root, testServer := NewTestRoot(t)
+		root.SetAPI(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			w.WriteHeader(nethttp.StatusTeapot)
+		}))
+
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusTeapot),
+		)
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	t.Run("200 - Counts API requests by operation", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		_, _, _, rootServer := NewTestRoot(t)
+
+		// Made without a token, so it's rejected before reaching the usecases.
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusUnauthorized),
+		)
+
+		hit.MustDo(
+			hit.Get("%s/metrics", rootServer.URL),
+			hit.Expect().Status().Equal(nethttp.StatusOK),
+			hit.Expect().Body().String().Contains(`serverpouch_http_requests_total{code="401",operation="ListServers"}`),
+			hit.Expect().Body().String().Contains(`serverpouch_http_request_duration_seconds_count{operation="ListServers"}`),
+		)
+	})
+}
@@ -23,7 +23,7 @@ func New(ctx context.Context) (*chi.Mux, error) {
 		usecases: usecases.UsecasesFromContext(ctx),
 	}
 
-	handler, err := openapi.New(httpImpl)
+	handler, err := openapi.New(httpImpl, httpImpl.usecases.Authenticate, problemFromError)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to instantiate openapi mux")
 	}
@@ -3,26 +3,69 @@ package http_test
 import (
 	"context"
 	"encoding/json"
+	nethttp "net/http"
 	"net/http/httptest"
 	"testing"
 
 	"oppossome/serverpouch/internal/delivery/http"
+	"oppossome/serverpouch/internal/domain/auth"
 	"oppossome/serverpouch/internal/domain/usecases"
 
 	mockUsecases "oppossome/serverpouch/internal/common/test/mocks/domain/usecases"
 
 	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+// testToken is accepted by every test server, and sent by its client.
+const testToken = "sp_test"
+
+// authTransport adds a bearer token to every request.
+type authTransport struct {
+	token string
+	base  nethttp.RoundTripper
+}
+
+func (at *authTransport) RoundTrip(r *nethttp.Request) (*nethttp.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+at.token)
+	return at.base.RoundTrip(r)
+}
+
+// NewTestServer creates a test server whose client is authenticated as an admin.
 func NewTestServer(t *testing.T) (context.Context, *mockUsecases.MockUsecases, *httptest.Server) {
+	return NewTestServerAs(t, &auth.User{ID: uuid.Nil, Name: "admin", IsAdmin: true})
+}
+
+// NewTestServerAs creates a test server whose client is authenticated as the user.
+func NewTestServerAs(t *testing.T, user *auth.User) (context.Context, *mockUsecases.MockUsecases, *httptest.Server) {
 	mockUsc := mockUsecases.NewMockUsecases(t)
 	tCtx := usecases.WithUsecases(t.Context(), mockUsc)
 
+	mockUsc.EXPECT().Authenticate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, secret string) (*auth.User, error) {
+		if secret != testToken {
+			return nil, errors.WithStack(auth.ErrInvalidToken)
+		}
+
+		return user, nil
+	}).Maybe()
+
 	router, err := http.New(tCtx)
 	assert.NoError(t, err, "Failed to initialize testing server")
 
-	return tCtx, mockUsc, httptest.NewServer(router)
+	testServer := httptest.NewServer(router)
+	testClient := testServer.Client()
+	testClient.Transport = &authTransport{token: testToken, base: testClient.Transport}
+
+	return tCtx, mockUsc, testServer
+}
+
+// authHeader returns the headers for requests made without the test client.
+func authHeader() nethttp.Header {
+	return nethttp.Header{"Authorization": []string{"Bearer " + testToken}}
 }
 
 func hitBodyJSONEquals(t *testing.T, expected interface{}) hit.IStep {
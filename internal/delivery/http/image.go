@@ -0,0 +1,48 @@
+package http
+
+import (
+	"context"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+
+	"github.com/pkg/errors"
+)
+
+// Check for a newer image
+// (GET /api/servers/{id}/update-image)
+func (hi *httpImpl) CheckServerImage(ctx context.Context, request openapi.CheckServerImageRequestObject) (openapi.CheckServerImageResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionView); err != nil {
+		return nil, err
+	}
+
+	update, err := hi.usecases.CheckServerImage(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check server image")
+	}
+
+	return openapi.CheckServerImage200JSONResponse{Update: openapi.ImageUpdateToOAPI(update)}, nil
+}
+
+// Update a server's image
+// (POST /api/servers/{id}/update-image)
+func (hi *httpImpl) UpdateServerImage(ctx context.Context, request openapi.UpdateServerImageRequestObject) (openapi.UpdateServerImageResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionConfigure); err != nil {
+		return nil, err
+	}
+
+	inst, update, err := hi.usecases.UpdateServerImage(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update server image")
+	}
+
+	oInst, err := openapi.ServerToOAPI(inst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode openapi server")
+	}
+
+	return openapi.UpdateServerImage200JSONResponse{
+		Server: *oInst,
+		Update: openapi.ImageUpdateToOAPI(update),
+	}, nil
+}
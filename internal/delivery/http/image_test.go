@@ -0,0 +1,118 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/infrastructure/docker"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckServerImage(t *testing.T) {
+	t.Run("200 - OK", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().CheckServerImage(mock.Anything, uuid.Nil).Return(&server.ImageUpdate{
+			Image:         "itzg/minecraft-server",
+			CurrentDigest: "sha256:current",
+			LatestDigest:  "sha256:latest",
+			Available:     true,
+		}, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/update-image", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".update.currentDigest").Equal("sha256:current"),
+			hit.Expect().Body().JSON().JQ(".update.latestDigest").Equal("sha256:latest"),
+			hit.Expect().Body().JSON().JQ(".update.available").Equal(true),
+			hit.Expect().Body().JSON().JQ(".update.applied").Equal(false),
+		)
+	})
+
+	t.Run("409 - Without a container", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		statusErr := &server.InvalidStatusError{Action: "Check image", Status: server.ServerInstanceStatusInitializing}
+		mockUsecases.EXPECT().CheckServerImage(mock.Anything, uuid.Nil).Return(nil, errors.Wrap(statusErr, "failed"))
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/update-image", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusConflict),
+		)
+	})
+}
+
+func TestUpdateServerImage(t *testing.T) {
+	t.Run("200 - OK", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: "test"})
+		inst.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
+
+		update := &server.ImageUpdate{
+			Image:        "test",
+			LatestDigest: "sha256:latest",
+			Available:    true,
+			Applied:      true,
+		}
+		mockUsecases.EXPECT().UpdateServerImage(mock.Anything, inst.Config().ID()).Return(inst, update, nil)
+
+		oInst, err := openapi.ServerToOAPI(inst)
+		assert.NoError(t, err)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/update-image", testServer.URL, inst.Config().ID()),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hitBodyJSONEquals(t, openapi.ServerImageUpdateResponse{
+				Server: *oInst,
+				Update: openapi.ImageUpdateToOAPI(update),
+			}),
+		)
+	})
+
+	t.Run("403 - Moderators can't update images", func(t *testing.T) {
+		_, _, testServer := NewTestServerAs(t, &auth.User{
+			ID:     uuid.New(),
+			Name:   "moderator",
+			Grants: map[uuid.UUID]auth.Role{uuid.Nil: auth.RoleModerator},
+		})
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/update-image", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().UpdateServerImage(mock.Anything, uuid.Nil).Return(nil, nil, errors.WithStack(server.ErrInstanceNotFound))
+
+		hit.MustDo(
+			hit.Post("%s/api/servers/%s/update-image", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+}
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Read a server's logs
+// (GET /api/servers/{id}/logs)
+func (hi *httpImpl) GetServerLogs(ctx context.Context, request openapi.GetServerLogsRequestObject) (openapi.GetServerLogsResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionConsole); err != nil {
+		return nil, err
+	}
+
+	params := request.Params
+	opts := server.LogOptions{
+		Follow: params.Follow != nil && *params.Follow,
+		Stdout: params.Stdout == nil || *params.Stdout,
+		Stderr: params.Stderr == nil || *params.Stderr,
+	}
+
+	now := time.Now()
+	var err error
+	if params.Since != nil {
+		if opts.Since, err = parseTimeParam(now, *params.Since); err != nil {
+			return nil, &invalidParamError{Param: "since", Reason: err.Error()}
+		}
+	}
+	if params.Until != nil {
+		if opts.Until, err = parseTimeParam(now, *params.Until); err != nil {
+			return nil, &invalidParamError{Param: "until", Reason: err.Error()}
+		}
+	}
+	opts.Tail = params.Tail
+
+	download := params.Download != nil && *params.Download
+	if download && opts.Follow {
+		return nil, &invalidParamError{Param: "download", Reason: "Logs can't be downloaded while following them"}
+	}
+
+	inst, err := hi.usecases.GetServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server")
+	}
+
+	// The logs are opened here rather than while responding, so that failing
+	// to do so can still be reported as a problem.
+	reader, err := inst.Logs(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read server logs")
+	}
+
+	return serverLogsResponse{
+		ctx:        ctx,
+		id:         request.Id,
+		reader:     reader,
+		timestamps: params.Timestamps != nil && *params.Timestamps,
+		follow:     opts.Follow,
+		download:   download,
+	}, nil
+}
+
+// parseTimeParam parses a time given either as RFC 3339, or as a duration
+// before now.
+func parseTimeParam(now time.Time, value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return time.Time{}, errors.New("Expected an RFC 3339 date and time, or a duration such as 10m")
+	}
+
+	return now.Add(-duration), nil
+}
+
+// serverLogsResponse streams the logs as NDJSON, or as a gzipped text file
+// when downloading them.
+type serverLogsResponse struct {
+	ctx        context.Context
+	id         uuid.UUID
+	reader     server.LogReader
+	timestamps bool
+	follow     bool
+	download   bool
+}
+
+func (slr serverLogsResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	defer slr.reader.Close()
+
+	var writeLine func(server.LogLine) error
+
+	if slr.download {
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.log.gz\"", slr.id))
+		w.WriteHeader(http.StatusOK)
+
+		gzipWriter := gzip.NewWriter(w)
+		defer gzipWriter.Close()
+
+		writeLine = func(line server.LogLine) error {
+			if slr.timestamps {
+				_, err := fmt.Fprintf(gzipWriter, "%s %s\n", line.Time.Format(time.RFC3339Nano), line.Text)
+				return err
+			}
+
+			_, err := fmt.Fprintln(gzipWriter, line.Text)
+			return err
+		}
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+
+		encoder := json.NewEncoder(w)
+		writeLine = func(line server.LogLine) error {
+			logLine := openapi.LogLine{
+				Stream: openapi.LogStream(line.Stream),
+				Text:   line.Text,
+			}
+			if slr.timestamps && !line.Time.IsZero() {
+				logLine.Timestamp = &line.Time
+			}
+
+			return encoder.Encode(logLine)
+		}
+	}
+
+	// Followed lines are sent as soon as they're printed.
+	flusher, _ := w.(http.Flusher)
+	flush := func() {
+		if slr.follow && flusher != nil {
+			flusher.Flush()
+		}
+	}
+	flush()
+
+	for {
+		line, err := slr.reader.Next()
+		switch {
+		case err == io.EOF, slr.ctx.Err() != nil:
+			return nil
+		case err != nil:
+			// The response has already begun, so all we can do is end it early.
+			zerolog.Ctx(slr.ctx).Err(err).Msg("failed to read server logs")
+			return nil
+		}
+
+		if err := writeLine(line); err != nil {
+			return nil
+		}
+		flush()
+	}
+}
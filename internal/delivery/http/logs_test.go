@@ -0,0 +1,153 @@
+package http_test
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// testLogReader reads back a fixed set of lines.
+type testLogReader struct {
+	lines []server.LogLine
+}
+
+func (tlr *testLogReader) Next() (server.LogLine, error) {
+	if len(tlr.lines) == 0 {
+		return server.LogLine{}, io.EOF
+	}
+
+	line := tlr.lines[0]
+	tlr.lines = tlr.lines[1:]
+	return line, nil
+}
+
+func (tlr *testLogReader) Close() error {
+	return nil
+}
+
+var testLogLines = []server.LogLine{
+	{Stream: server.LogStreamStdout, Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), Text: "Hello"},
+	{Stream: server.LogStreamStderr, Time: time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC), Text: "Oops"},
+}
+
+func TestGetServerLogs(t *testing.T) {
+	t.Run("200 - NDJSON", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Logs(mock.Anything, mock.MatchedBy(func(opts server.LogOptions) bool {
+			return opts.Tail != nil && *opts.Tail == 10 && opts.Stdout && !opts.Stderr && time.Since(opts.Since) > 9*time.Minute
+		})).Return(&testLogReader{lines: testLogLines[:1]}, nil)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/logs?tail=10&stderr=false&since=10m", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("Content-Type").Equal("application/x-ndjson"),
+			hit.Expect().Body().String().Equal("{\"stream\":\"stdout\",\"text\":\"Hello\"}\n"),
+		)
+	})
+
+	t.Run("200 - Timestamps", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Logs(mock.Anything, mock.Anything).Return(&testLogReader{lines: testLogLines}, nil)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/logs?timestamps=true", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().String().Equal(
+				"{\"stream\":\"stdout\",\"text\":\"Hello\",\"timestamp\":\"2025-01-02T03:04:05Z\"}\n"+
+					"{\"stream\":\"stderr\",\"text\":\"Oops\",\"timestamp\":\"2025-01-02T03:04:06Z\"}\n",
+			),
+		)
+	})
+
+	t.Run("200 - Download", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Logs(mock.Anything, mock.Anything).Return(&testLogReader{lines: testLogLines}, nil)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		req, err := http.NewRequest(http.MethodGet, testServer.URL+"/api/servers/"+uuid.Nil.String()+"/logs?download=true", nil)
+		assert.NoError(t, err)
+
+		resp, err := testServer.Client().Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/gzip", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=\""+uuid.Nil.String()+".log.gz\"", resp.Header.Get("Content-Disposition"))
+
+		gzipReader, err := gzip.NewReader(resp.Body)
+		assert.NoError(t, err)
+
+		body, err := io.ReadAll(gzipReader)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello\nOops\n", string(body))
+	})
+
+	t.Run("400 - Invalid since", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/logs?since=yesterday", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("since"),
+		)
+	})
+
+	t.Run("400 - Download while following", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/logs?download=true&follow=true", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("download"),
+		)
+	})
+
+	t.Run("409 - No container", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Logs(mock.Anything, mock.Anything).Return(nil, &server.InvalidStatusError{
+			Action: "Logs",
+			Status: server.ServerInstanceStatusInitializing,
+		})
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/logs", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusConflict),
+		)
+	})
+}
@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"oppossome/serverpouch/internal/domain/auth"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// AuthenticateFunc resolves a bearer token to the user it belongs to.
+type AuthenticateFunc func(ctx context.Context, secret string) (*auth.User, error)
+
+// authMiddleware authenticates the request's bearer token, if any. Whether an
+// operation actually requires a token is left to the request validator, which
+// calls authenticationFunc for every security requirement in the spec.
+func authMiddleware(authenticate AuthenticateFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			secret, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok {
+				unauthorized(w, r, "Malformed authorization header")
+				return
+			}
+
+			user, err := authenticate(r.Context(), secret)
+			if err != nil {
+				if !errors.Is(err, auth.ErrInvalidToken) {
+					zerolog.Ctx(r.Context()).Err(err).Msg("Failed to authenticate request")
+				}
+
+				unauthorized(w, r, "Invalid token")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithUser(r.Context(), user)))
+		})
+	}
+}
+
+func authenticationFunc(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	if input.SecuritySchemeName != "bearerAuth" {
+		return errors.Errorf("unsupported security scheme \"%s\"", input.SecuritySchemeName)
+	}
+
+	if _, ok := auth.UserFromContext(input.RequestValidationInput.Request.Context()); !ok {
+		return errors.New("missing bearer token")
+	}
+
+	return nil
+}
+
+func unauthorized(w http.ResponseWriter, r *http.Request, detail string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	WriteError(w, r, unauthorizedProblem(detail))
+}
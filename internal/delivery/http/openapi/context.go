@@ -0,0 +1,29 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+)
+
+var requestKey = &struct{ name string }{"request"}
+
+func WithRequest(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, requestKey, r)
+}
+
+func RequestFromContext(ctx context.Context) *http.Request {
+	r, ok := ctx.Value(requestKey).(*http.Request)
+	if !ok {
+		panic("Request not found in context!")
+	}
+
+	return r
+}
+
+// requestMiddleware exposes the underlying request to strict handlers which
+// need more than the request object, such as upgrading to a WebSocket.
+func requestMiddleware(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return f(WithRequest(ctx, r), w, r, request)
+	}
+}
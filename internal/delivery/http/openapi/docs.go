@@ -0,0 +1,41 @@
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+)
+
+var (
+	//go:embed openapi.yml
+	specYAML []byte
+
+	//go:embed docs.html
+	docsHTML []byte
+)
+
+// mountDocs serves the spec and an explorer for it. They're public, as the
+// spec describes the API rather than anything served by it.
+func mountDocs(router chi.Router, swagger *openapi3.T) error {
+	specJSON, err := swagger.MarshalJSON()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode spec")
+	}
+
+	router.Get("/api/openapi.json", serveBytes("application/json", specJSON))
+	router.Get("/api/openapi.yaml", serveBytes("application/yaml", specYAML))
+	router.Get("/api/docs", serveBytes("text/html; charset=utf-8", docsHTML))
+
+	return nil
+}
+
+func serveBytes(contentType string, body []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Write(body)
+	}
+}
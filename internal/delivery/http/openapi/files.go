@@ -0,0 +1,14 @@
+package openapi
+
+import "oppossome/serverpouch/internal/domain/server"
+
+// MARK: FileToOAPI
+
+func FileToOAPI(file server.FileInfo) FileInfo {
+	return FileInfo{
+		Path:       file.Path,
+		Type:       FileInfoType(file.Type),
+		Size:       file.Size,
+		ModifiedAt: file.ModTime,
+	}
+}
@@ -0,0 +1,20 @@
+package openapi
+
+import "oppossome/serverpouch/internal/domain/server"
+
+// MARK: ImageUpdateToOAPI
+
+func ImageUpdateToOAPI(update *server.ImageUpdate) ImageUpdate {
+	oUpdate := ImageUpdate{
+		Image:        update.Image,
+		LatestDigest: update.LatestDigest,
+		Available:    update.Available,
+		Applied:      update.Applied,
+	}
+
+	if update.CurrentDigest != "" {
+		oUpdate.CurrentDigest = &update.CurrentDigest
+	}
+
+	return oUpdate
+}
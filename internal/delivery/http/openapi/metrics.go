@@ -0,0 +1,38 @@
+package openapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"oppossome/serverpouch/internal/common/metrics"
+
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// metricsMiddleware records every API request under its operationId, including
+// those rejected before reaching their handler.
+func metricsMiddleware(routes routers.Router) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			operation := "unknown"
+			if route, _, err := routes.FindRoute(r); err == nil && route.Operation != nil {
+				operation = route.Operation.OperationID
+			}
+
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			// Nothing is written through hijacked connections, such as websockets.
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusSwitchingProtocols
+			}
+
+			metrics.HTTPRequests.WithLabelValues(operation, strconv.Itoa(status)).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		})
+	}
+}
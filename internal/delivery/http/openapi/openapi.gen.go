@@ -10,10 +10,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
@@ -22,8 +24,53 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for FileInfoType.
+const (
+	FileTypeDirectory FileInfoType = "directory"
+	FileTypeFile      FileInfoType = "file"
+	FileTypeSymlink   FileInfoType = "symlink"
+)
+
+// Defines values for LogStream.
+const (
+	LogStreamStderr LogStream = "stderr"
+	LogStreamStdout LogStream = "stdout"
+)
+
+// Defines values for ReadinessProbeType.
+const (
+	ReadinessProbeTypeConsole ReadinessProbeType = "console"
+	ReadinessProbeTypeExec    ReadinessProbeType = "exec"
+	ReadinessProbeTypeHttp    ReadinessProbeType = "http"
+	ReadinessProbeTypeTcp     ReadinessProbeType = "tcp"
+)
+
+// Defines values for RestartPolicyMode.
+const (
+	RestartPolicyModeAlways    RestartPolicyMode = "always"
+	RestartPolicyModeNever     RestartPolicyMode = "never"
+	RestartPolicyModeOnFailure RestartPolicyMode = "on-failure"
+)
+
+// Defines values for ServerConfigDockerType.
+const (
+	ServerConfigDockerTypeDocker ServerConfigDockerType = "docker"
+)
+
+// Defines values for ServerRole.
+const (
+	Manager   ServerRole = "manager"
+	Moderator ServerRole = "moderator"
+	Viewer    ServerRole = "viewer"
+)
+
 // Defines values for ServerStatus.
 const (
+	Crashlooping ServerStatus = "crashlooping"
 	Errored      ServerStatus = "errored"
 	Idle         ServerStatus = "idle"
 	Initializing ServerStatus = "initializing"
@@ -32,33 +79,351 @@ const (
 	Stopping     ServerStatus = "stopping"
 )
 
-// Defines values for ServerConfigDockerType.
+// Defines values for ListServersParamsType.
+const (
+	ListServersParamsTypeDocker ListServersParamsType = "docker"
+)
+
+// Defines values for ListServersParamsSort.
+const (
+	ServerSortCreatedAt ListServersParamsSort = "createdAt"
+	ServerSortName      ListServersParamsSort = "name"
+)
+
+// Defines values for ListServersParamsOrder.
 const (
-	Docker ServerConfigDockerType = "docker"
+	SortOrderAsc  ListServersParamsOrder = "asc"
+	SortOrderDesc ListServersParamsOrder = "desc"
 )
 
+// APIToken defines model for APIToken.
+type APIToken struct {
+	// CreatedAt The date and time the token was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id The unique identifier for the resource
+	Id openapi_types.UUID `json:"id"`
+
+	// LastUsedAt The date and time the token was last used
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name A name to recognize the token by
+	Name string `json:"name"`
+
+	// RevokedAt The date and time the token was revoked
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// UpdatedAt The date and time the resource was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// UserId The user the token authenticates as
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// APITokensResponse defines model for APITokensResponse.
+type APITokensResponse struct {
+	Tokens []APIToken `json:"tokens"`
+}
+
 // BaseResource defines model for BaseResource.
 type BaseResource struct {
+	// CreatedAt The date and time the resource was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
 	// Id The unique identifier for the resource
 	Id openapi_types.UUID `json:"id"`
+
+	// UpdatedAt The date and time the resource was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ConsoleHistoryResponse defines model for ConsoleHistoryResponse.
+type ConsoleHistoryResponse struct {
+	Lines []ConsoleLine `json:"lines"`
+
+	// Truncated Whether lines after `since` were dropped, as only the most recent lines are kept
+	Truncated bool `json:"truncated"`
+}
+
+// ConsoleLine defines model for ConsoleLine.
+type ConsoleLine struct {
+	// Seq The line's sequence number
+	Seq  int64  `json:"seq"`
+	Text string `json:"text"`
+
+	// Timestamp The date and time the line was printed
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// DockerResources Limits on what the server can use of the host. Omitted limits are unlimited.
+type DockerResources struct {
+	// CpuShares The server's weight against others when the CPU is contended, where 1024 is the default
+	CpuShares *int64 `json:"cpuShares,omitempty"`
+
+	// Cpus The CPU quota, as a number of CPUs
+	Cpus *float64 `json:"cpus,omitempty"`
+
+	// Cpuset The CPUs the server may run on
+	Cpuset *string `json:"cpuset,omitempty"`
+
+	// Memory The hard memory limit in bytes, at least 6MiB
+	Memory *int64 `json:"memory,omitempty"`
+
+	// MemoryReservation The soft memory limit in bytes, enforced when the host is low on memory
+	MemoryReservation *int64 `json:"memoryReservation,omitempty"`
+
+	// MemorySwap The limit on memory plus swap in bytes, or -1 for unlimited swap. Requires a memory limit.
+	MemorySwap *int64 `json:"memorySwap,omitempty"`
+
+	// PidsLimit The most processes the server may run at once
+	PidsLimit *int64 `json:"pidsLimit,omitempty"`
+}
+
+// Error An RFC 7807 problem details object, describing why a request failed
+type Error struct {
+	// Detail An explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors The individual validation errors, if the request was invalid
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance The path of the request which failed
+	Instance *string `json:"instance,omitempty"`
+
+	// Status The HTTP status code
+	Status int `json:"status"`
+
+	// Title A short summary of the kind of problem
+	Title string `json:"title"`
+
+	// Type A URI identifying the kind of problem
+	Type string `json:"type"`
+}
+
+// ExecResult defines model for ExecResult.
+type ExecResult struct {
+	ExitCode int    `json:"exitCode"`
+	Stderr   string `json:"stderr"`
+	Stdout   string `json:"stdout"`
+
+	// Truncated Whether either stream printed more than was captured
+	Truncated bool `json:"truncated"`
+}
+
+// ExecStreamLine A line the command printed, or once it's exited, its exit code
+type ExecStreamLine struct {
+	ExitCode *int       `json:"exitCode,omitempty"`
+	Stream   *LogStream `json:"stream,omitempty"`
+	Text     *string    `json:"text,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field The offending parameter's name, or a JSON pointer into the request body
+	Field string `json:"field"`
+
+	// Message Why the field is invalid
+	Message string `json:"message"`
+}
+
+// FileInfo defines model for FileInfo.
+type FileInfo struct {
+	ModifiedAt time.Time `json:"modifiedAt"`
+
+	// Path The file's path within the container
+	Path string `json:"path"`
+
+	// Size The file's size in bytes
+	Size int64        `json:"size"`
+	Type FileInfoType `json:"type"`
+}
+
+// FileInfoType defines model for FileInfo.Type.
+type FileInfoType string
+
+// FileRename defines model for FileRename.
+type FileRename struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// FileResponse defines model for FileResponse.
+type FileResponse struct {
+	File FileInfo `json:"file"`
+}
+
+// FilesResponse defines model for FilesResponse.
+type FilesResponse struct {
+	Files []FileInfo `json:"files"`
+}
+
+// ImageUpdate defines model for ImageUpdate.
+type ImageUpdate struct {
+	// Applied Whether the server was recreated from the latest image
+	Applied bool `json:"applied"`
+
+	// Available Whether the latest image differs from the container's
+	Available bool `json:"available"`
+
+	// CurrentDigest The digest of the container's image, missing if it wasn't pulled from a registry
+	CurrentDigest *string `json:"currentDigest,omitempty"`
+	Image         string  `json:"image"`
+
+	// LatestDigest The digest the image's tag resolves to
+	LatestDigest string `json:"latestDigest"`
+}
+
+// ImageUpdateResponse defines model for ImageUpdateResponse.
+type ImageUpdateResponse struct {
+	Update ImageUpdate `json:"update"`
+}
+
+// LogLine defines model for LogLine.
+type LogLine struct {
+	Stream LogStream `json:"stream"`
+	Text   string    `json:"text"`
+
+	// Timestamp The date and time the line was printed, if timestamps were requested
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// LogStream defines model for LogStream.
+type LogStream string
+
+// NewAPIToken defines model for NewAPIToken.
+type NewAPIToken struct {
+	// Name A name to recognize the token by
+	Name string `json:"name"`
+
+	// UserId The user the token authenticates as, defaults to the caller
+	UserId *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// NewAPITokenResponse defines model for NewAPITokenResponse.
+type NewAPITokenResponse struct {
+	// Secret The token's secret, to be sent as a bearer token
+	Secret string   `json:"secret"`
+	Token  APIToken `json:"token"`
+}
+
+// NewDirectory defines model for NewDirectory.
+type NewDirectory struct {
+	// Path The directory's path within the container
+	Path string `json:"path"`
+}
+
+// NewExec defines model for NewExec.
+type NewExec struct {
+	// Command The command followed by its arguments
+	Command []string `json:"command"`
+
+	// Environment Added to the server's environment, as KEY=value
+	Environment *[]string `json:"environment,omitempty"`
+
+	// User A user or user:group to run as, defaulting to the image's user
+	User *string `json:"user,omitempty"`
+
+	// WorkingDir Defaults to the image's working directory
+	WorkingDir *string `json:"workingDir,omitempty"`
 }
 
 // NewServer defines model for NewServer.
 type NewServer struct {
 	Config ServerConfig `json:"config"`
+
+	// Description Notes on what the server is for
+	Description *string `json:"description,omitempty"`
+
+	// Labels Free-form labels to organize and filter servers by
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Name A human readable name for the server
+	Name string `json:"name"`
+}
+
+// NewServerGrant defines model for NewServerGrant.
+type NewServerGrant struct {
+	// Role viewer may see the server, moderator may additionally use its console and start or stop it, manager may additionally change or delete it. Only admins may change a server's image, volumes or ports, or purge its data.
+	Role ServerRole `json:"role"`
+}
+
+// NewUser defines model for NewUser.
+type NewUser struct {
+	// IsAdmin Whether the user may do anything, including managing users
+	IsAdmin *bool `json:"isAdmin,omitempty"`
+
+	// Name The user's unique name
+	Name string `json:"name"`
+}
+
+// ReadinessProbe Decides when a starting server is ready. The server stays starting until the probe passes, and is errored should it not pass in time.
+type ReadinessProbe struct {
+	// Command The command exec probes run within the server, passing once it exits 0
+	Command *[]string `json:"command,omitempty"`
+
+	// Interval Seconds between attempts, which each may take as long. Defaults to 5.
+	Interval *int `json:"interval,omitempty"`
+
+	// Path The path http probes request, passing on any 2xx or 3xx response
+	Path *string `json:"path,omitempty"`
+
+	// Pattern The regular expression console probes match each line of output against
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Port The published host port tcp and http probes connect to
+	Port *int `json:"port,omitempty"`
+
+	// Timeout Seconds the probe has to pass. Defaults to 600.
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Type Whether to connect to a port, request a page, watch the console, or run a command
+	Type ReadinessProbeType `json:"type"`
+}
+
+// ReadinessProbeType Whether to connect to a port, request a page, watch the console, or run a command
+type ReadinessProbeType string
+
+// RestartPolicy Whether the server is restarted after exiting on its own. Restarts back off exponentially, from 5 seconds up to 5 minutes, and the server is crashlooping while it waits.
+type RestartPolicy struct {
+	// MaxRetries How many restarts in a row may fail before the server is left errored, or 0 to never give up. Restarts start over once the server stays up for 10 minutes, or is started by hand.
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// Mode Whether to restart never, after a non-zero exit code, or after any exit
+	Mode RestartPolicyMode `json:"mode"`
 }
 
+// RestartPolicyMode Whether to restart never, after a non-zero exit code, or after any exit
+type RestartPolicyMode string
+
 // Server defines model for Server.
 type Server struct {
 	Config ServerConfig `json:"config"`
 
+	// Crashes How the server has been exiting on its own since the daemon started
+	Crashes ServerCrashes `json:"crashes"`
+
+	// CreatedAt The date and time the resource was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description Notes on what the server is for
+	Description *string `json:"description,omitempty"`
+
 	// Id The unique identifier for the resource
-	Id     openapi_types.UUID `json:"id"`
-	Status ServerStatus       `json:"status"`
-}
+	Id openapi_types.UUID `json:"id"`
 
-// ServerStatus defines model for Server.Status.
-type ServerStatus string
+	// Labels Free-form labels to organize and filter servers by
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Name A human readable name for the server
+	Name   string       `json:"name"`
+	Status ServerStatus `json:"status"`
+
+	// StatusReason Why the server is errored, absent otherwise
+	StatusReason *string `json:"statusReason,omitempty"`
+
+	// UpdatedAt The date and time the resource was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
 // ServerConfig defines model for ServerConfig.
 type ServerConfig struct {
@@ -74,8 +439,17 @@ type ServerConfigDocker struct {
 	Image string `json:"image"`
 
 	// Ports The ports to expose on the server
-	Ports []string               `json:"ports"`
-	Type  ServerConfigDockerType `json:"type"`
+	Ports []string `json:"ports"`
+
+	// ReadinessProbe Decides when a starting server is ready. The server stays starting until the probe passes, and is errored should it not pass in time.
+	ReadinessProbe *ReadinessProbe `json:"readinessProbe,omitempty"`
+
+	// Resources Limits on what the server can use of the host. Omitted limits are unlimited.
+	Resources *DockerResources `json:"resources,omitempty"`
+
+	// RestartPolicy Whether the server is restarted after exiting on its own. Restarts back off exponentially, from 5 seconds up to 5 minutes, and the server is crashlooping while it waits.
+	RestartPolicy *RestartPolicy         `json:"restartPolicy,omitempty"`
+	Type          ServerConfigDockerType `json:"type"`
 
 	// Volumes The volumes to mount on the server
 	Volumes []string `json:"volumes"`
@@ -84,19 +458,288 @@ type ServerConfigDocker struct {
 // ServerConfigDockerType defines model for ServerConfigDocker.Type.
 type ServerConfigDockerType string
 
+// ServerCrashes How the server has been exiting on its own since the daemon started
+type ServerCrashes struct {
+	// Count How many times the server has exited with a non-zero exit code
+	Count int `json:"count"`
+
+	// ExitCode The exit code the server last exited on its own with, absent if it hasn't
+	ExitCode *int `json:"exitCode,omitempty"`
+
+	// LastCrashAt When the server last crashed, absent if it hasn't
+	LastCrashAt *time.Time `json:"lastCrashAt,omitempty"`
+}
+
+// ServerGrant defines model for ServerGrant.
+type ServerGrant struct {
+	// Role viewer may see the server, moderator may additionally use its console and start or stop it, manager may additionally change or delete it. Only admins may change a server's image, volumes or ports, or purge its data.
+	Role     ServerRole         `json:"role"`
+	ServerId openapi_types.UUID `json:"serverId"`
+}
+
+// ServerImageUpdateResponse defines model for ServerImageUpdateResponse.
+type ServerImageUpdateResponse struct {
+	Server Server      `json:"server"`
+	Update ImageUpdate `json:"update"`
+}
+
 // ServerResponse defines model for ServerResponse.
 type ServerResponse struct {
 	Server Server `json:"server"`
 }
 
+// ServerRole viewer may see the server, moderator may additionally use its console and start or stop it, manager may additionally change or delete it. Only admins may change a server's image, volumes or ports, or purge its data.
+type ServerRole string
+
+// ServerStats defines model for ServerStats.
+type ServerStats struct {
+	// BlockRead Bytes read from disk since the server started
+	BlockRead int64 `json:"blockRead"`
+
+	// BlockWrite Bytes written to disk since the server started
+	BlockWrite int64 `json:"blockWrite"`
+
+	// CpuPercent CPU usage relative to a single core, so a server busy on two cores reaches 200
+	CpuPercent  float64 `json:"cpuPercent"`
+	MemoryLimit int64   `json:"memoryLimit"`
+
+	// MemoryUsage Memory used in bytes, not counting the page cache
+	MemoryUsage int64 `json:"memoryUsage"`
+
+	// NetworkRx Bytes received since the server started
+	NetworkRx int64 `json:"networkRx"`
+
+	// NetworkTx Bytes sent since the server started
+	NetworkTx int64 `json:"networkTx"`
+
+	// Pids The number of processes and threads
+	Pids int64     `json:"pids"`
+	Time time.Time `json:"time"`
+}
+
+// ServerStatsResponse defines model for ServerStatsResponse.
+type ServerStatsResponse struct {
+	Stats ServerStats `json:"stats"`
+}
+
+// ServerStatus defines model for ServerStatus.
+type ServerStatus string
+
+// ServerStatusEvent defines model for ServerStatusEvent.
+type ServerStatusEvent struct {
+	// Id The ID of the server whose status changed
+	Id        openapi_types.UUID `json:"id"`
+	NewStatus ServerStatus       `json:"newStatus"`
+	OldStatus ServerStatus       `json:"oldStatus"`
+
+	// Timestamp The date and time the status changed
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // ServersResponse defines model for ServersResponse.
 type ServersResponse struct {
-	Servers []Server `json:"servers"`
+	// NextCursor The cursor to the next page, absent on the last page
+	NextCursor *string  `json:"nextCursor,omitempty"`
+	Servers    []Server `json:"servers"`
+}
+
+// StatsHistoryResponse defines model for StatsHistoryResponse.
+type StatsHistoryResponse struct {
+	// Resolution The number of seconds each sample covers
+	Resolution int           `json:"resolution"`
+	Samples    []ServerStats `json:"samples"`
+}
+
+// User defines model for User.
+type User struct {
+	// CreatedAt The date and time the user was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Grants The roles the user holds on individual servers
+	Grants []ServerGrant `json:"grants"`
+
+	// Id The unique identifier for the resource
+	Id openapi_types.UUID `json:"id"`
+
+	// IsAdmin Whether the user may do anything, including managing users
+	IsAdmin bool `json:"isAdmin"`
+
+	// Name The user's unique name
+	Name string `json:"name"`
+
+	// UpdatedAt The date and time the resource was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+// UsersResponse defines model for UsersResponse.
+type UsersResponse struct {
+	Users []User `json:"users"`
+}
+
+// GrantServerID defines model for GrantServerID.
+type GrantServerID = openapi_types.UUID
+
+// ServerID defines model for ServerID.
+type ServerID = openapi_types.UUID
+
+// TokenID defines model for TokenID.
+type TokenID = openapi_types.UUID
+
+// UserID defines model for UserID.
+type UserID = openapi_types.UUID
+
+// Forbidden An RFC 7807 problem details object, describing why a request failed
+type Forbidden = Error
+
+// Unauthorized An RFC 7807 problem details object, describing why a request failed
+type Unauthorized = Error
+
+// ListServersParams defines parameters for ListServers.
+type ListServersParams struct {
+	// Status Only include servers with any of these statuses
+	Status *[]ServerStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Type Only include servers of any of these types
+	Type *[]ListServersParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Image Only include servers using this image
+	Image *string `form:"image,omitempty" json:"image,omitempty"`
+
+	// Label Only include servers carrying every one of these labels, given as `key=value`, or just `key` to match any value
+	Label *[]string               `form:"label,omitempty" json:"label,omitempty"`
+	Sort  *ListServersParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order *ListServersParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor The nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit The maximum number of servers to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListServersParamsType defines parameters for ListServers.
+type ListServersParamsType string
+
+// ListServersParamsSort defines parameters for ListServers.
+type ListServersParamsSort string
+
+// ListServersParamsOrder defines parameters for ListServers.
+type ListServersParamsOrder string
+
+// DeleteServerParams defines parameters for DeleteServer.
+type DeleteServerParams struct {
+	// PurgeData Whether to remove the server's volumes and bind mounted data. Only admins may purge data.
+	PurgeData *bool `form:"purgeData,omitempty" json:"purgeData,omitempty"`
+}
+
+// UpdateServerParams defines parameters for UpdateServer.
+type UpdateServerParams struct {
+	// IfMatch The ETag of the revision the update was made against, or * to update whichever revision is current. Though optional here, updates without it are rejected with a 428.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetConsoleHistoryParams defines parameters for GetConsoleHistory.
+type GetConsoleHistoryParams struct {
+	// Since Only include lines after this sequence number
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// DeleteFileParams defines parameters for DeleteFile.
+type DeleteFileParams struct {
+	// Path The file's path within the container
+	Path string `form:"path" json:"path"`
+
+	// Recursive Delete directories along with everything in them
+	Recursive *bool `form:"recursive,omitempty" json:"recursive,omitempty"`
+}
+
+// ListFilesParams defines parameters for ListFiles.
+type ListFilesParams struct {
+	// Path The directory's path within the container
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+}
+
+// DownloadFileParams defines parameters for DownloadFile.
+type DownloadFileParams struct {
+	// Path The file's path within the container
+	Path string `form:"path" json:"path"`
+}
+
+// UploadFileParams defines parameters for UploadFile.
+type UploadFileParams struct {
+	// Path The file's path within the container
+	Path string `form:"path" json:"path"`
+}
+
+// GetServerLogsParams defines parameters for GetServerLogs.
+type GetServerLogsParams struct {
+	// Since Only include lines printed after this, given as an RFC 3339 date and time or as a duration before now such as `10m`
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include lines printed before this, given as an RFC 3339 date and time or as a duration before now such as `10m`
+	Until *string `form:"until,omitempty" json:"until,omitempty"`
+
+	// Tail Only include this many of the most recent lines, or all of them if omitted
+	Tail *int `form:"tail,omitempty" json:"tail,omitempty"`
+
+	// Timestamps Include the time each line was printed
+	Timestamps *bool `form:"timestamps,omitempty" json:"timestamps,omitempty"`
+
+	// Follow Keep sending lines as they're printed, until the server stops
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+	Stdout *bool `form:"stdout,omitempty" json:"stdout,omitempty"`
+	Stderr *bool `form:"stderr,omitempty" json:"stderr,omitempty"`
+
+	// Download Send the logs as a gzipped attachment, which can't be combined with follow
+	Download *bool `form:"download,omitempty" json:"download,omitempty"`
+}
+
+// GetServerStatsParams defines parameters for GetServerStats.
+type GetServerStatsParams struct {
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+}
+
+// GetServerStatsHistoryParams defines parameters for GetServerStatsHistory.
+type GetServerStatsHistoryParams struct {
+	// Since Only include samples after this, given as an RFC 3339 date and time or as a duration before now such as `168h`. Defaults to a day ago.
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include samples before this, given as an RFC 3339 date and time or as a duration before now such as `1h`. Defaults to now.
+	Until *string `form:"until,omitempty" json:"until,omitempty"`
 }
 
 // CreateServerJSONRequestBody defines body for CreateServer for application/json ContentType.
 type CreateServerJSONRequestBody = NewServer
 
+// UpdateServerJSONRequestBody defines body for UpdateServer for application/json ContentType.
+type UpdateServerJSONRequestBody = NewServer
+
+// ExecCommandJSONRequestBody defines body for ExecCommand for application/json ContentType.
+type ExecCommandJSONRequestBody = NewExec
+
+// StreamExecCommandJSONRequestBody defines body for StreamExecCommand for application/json ContentType.
+type StreamExecCommandJSONRequestBody = NewExec
+
+// CreateDirectoryJSONRequestBody defines body for CreateDirectory for application/json ContentType.
+type CreateDirectoryJSONRequestBody = NewDirectory
+
+// RenameFileJSONRequestBody defines body for RenameFile for application/json ContentType.
+type RenameFileJSONRequestBody = FileRename
+
+// CreateAPITokenJSONRequestBody defines body for CreateAPIToken for application/json ContentType.
+type CreateAPITokenJSONRequestBody = NewAPIToken
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = NewUser
+
+// SetServerGrantJSONRequestBody defines body for SetServerGrant for application/json ContentType.
+type SetServerGrantJSONRequestBody = NewServerGrant
+
 // AsServerConfigDocker returns the union data inside the ServerConfig as a ServerConfigDocker
 func (t ServerConfig) AsServerConfigDocker() (ServerConfigDocker, error) {
 	var body ServerConfigDocker
@@ -135,24 +778,123 @@ func (t *ServerConfig) UnmarshalJSON(b []byte) error {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Stream status changes across all servers
+	// (GET /api/events)
+	ServerEvents(w http.ResponseWriter, r *http.Request)
 	// List all servers
 	// (GET /api/servers)
-	ListServers(w http.ResponseWriter, r *http.Request)
+	ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams)
 	// Create a new server
 	// (POST /api/servers)
 	CreateServer(w http.ResponseWriter, r *http.Request)
+	// Delete a server
+	// (DELETE /api/servers/{id})
+	DeleteServer(w http.ResponseWriter, r *http.Request, id ServerID, params DeleteServerParams)
 	// Get a server by ID
 	// (GET /api/servers/{id})
 	GetServer(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a server's configuration
+	// (PUT /api/servers/{id})
+	UpdateServer(w http.ResponseWriter, r *http.Request, id ServerID, params UpdateServerParams)
+	// Open an interactive console to a server
+	// (GET /api/servers/{id}/console)
+	ServerConsole(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Get a server's recent console output
+	// (GET /api/servers/{id}/console/history)
+	GetConsoleHistory(w http.ResponseWriter, r *http.Request, id ServerID, params GetConsoleHistoryParams)
+	// Run a command within a server
+	// (POST /api/servers/{id}/exec)
+	ExecCommand(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Run a command within a server, streaming its output
+	// (POST /api/servers/{id}/exec/stream)
+	StreamExecCommand(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Delete a file within a server's volumes
+	// (DELETE /api/servers/{id}/files)
+	DeleteFile(w http.ResponseWriter, r *http.Request, id ServerID, params DeleteFileParams)
+	// List a directory within a server's volumes
+	// (GET /api/servers/{id}/files)
+	ListFiles(w http.ResponseWriter, r *http.Request, id ServerID, params ListFilesParams)
+	// Download a file within a server's volumes
+	// (GET /api/servers/{id}/files/content)
+	DownloadFile(w http.ResponseWriter, r *http.Request, id ServerID, params DownloadFileParams)
+	// Upload a file within a server's volumes
+	// (PUT /api/servers/{id}/files/content)
+	UploadFile(w http.ResponseWriter, r *http.Request, id ServerID, params UploadFileParams)
+	// Create a directory within a server's volumes
+	// (POST /api/servers/{id}/files/directories)
+	CreateDirectory(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Rename or move a file within one of a server's volumes
+	// (POST /api/servers/{id}/files/rename)
+	RenameFile(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Forcefully kill a server
+	// (POST /api/servers/{id}/kill)
+	KillServer(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Read a server's logs
+	// (GET /api/servers/{id}/logs)
+	GetServerLogs(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerLogsParams)
+	// Restart a server
+	// (POST /api/servers/{id}/restart)
+	RestartServer(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Start a server
+	// (POST /api/servers/{id}/start)
+	StartServer(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Read a server's resource usage
+	// (GET /api/servers/{id}/stats)
+	GetServerStats(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerStatsParams)
+	// Read a server's recorded resource usage
+	// (GET /api/servers/{id}/stats/history)
+	GetServerStatsHistory(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerStatsHistoryParams)
+	// Gracefully stop a server
+	// (POST /api/servers/{id}/stop)
+	StopServer(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Check for a newer image
+	// (GET /api/servers/{id}/update-image)
+	CheckServerImage(w http.ResponseWriter, r *http.Request, id ServerID)
+	// Update a server's image
+	// (POST /api/servers/{id}/update-image)
+	UpdateServerImage(w http.ResponseWriter, r *http.Request, id ServerID)
+	// List all API tokens
+	// (GET /api/tokens)
+	ListAPITokens(w http.ResponseWriter, r *http.Request)
+	// Create a new API token
+	// (POST /api/tokens)
+	CreateAPIToken(w http.ResponseWriter, r *http.Request)
+	// Revoke an API token
+	// (DELETE /api/tokens/{id})
+	RevokeAPIToken(w http.ResponseWriter, r *http.Request, id TokenID)
+	// List all users
+	// (GET /api/users)
+	ListUsers(w http.ResponseWriter, r *http.Request)
+	// Create a new user
+	// (POST /api/users)
+	CreateUser(w http.ResponseWriter, r *http.Request)
+	// Get the user the request is authenticated as
+	// (GET /api/users/me)
+	GetCurrentUser(w http.ResponseWriter, r *http.Request)
+	// Delete a user along with their tokens and grants
+	// (DELETE /api/users/{id})
+	DeleteUser(w http.ResponseWriter, r *http.Request, id UserID)
+	// Revoke a user's role on a server
+	// (DELETE /api/users/{id}/grants/{serverId})
+	DeleteServerGrant(w http.ResponseWriter, r *http.Request, id UserID, serverId GrantServerID)
+	// Grant a user a role on a server
+	// (PUT /api/users/{id}/grants/{serverId})
+	SetServerGrant(w http.ResponseWriter, r *http.Request, id UserID, serverId GrantServerID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Stream status changes across all servers
+// (GET /api/events)
+func (_ Unimplemented) ServerEvents(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all servers
 // (GET /api/servers)
-func (_ Unimplemented) ListServers(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -162,31 +904,306 @@ func (_ Unimplemented) CreateServer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete a server
+// (DELETE /api/servers/{id})
+func (_ Unimplemented) DeleteServer(w http.ResponseWriter, r *http.Request, id ServerID, params DeleteServerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a server by ID
 // (GET /api/servers/{id})
 func (_ Unimplemented) GetServer(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Update a server's configuration
+// (PUT /api/servers/{id})
+func (_ Unimplemented) UpdateServer(w http.ResponseWriter, r *http.Request, id ServerID, params UpdateServerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Open an interactive console to a server
+// (GET /api/servers/{id}/console)
+func (_ Unimplemented) ServerConsole(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// ListServers operation middleware
-func (siw *ServerInterfaceWrapper) ListServers(w http.ResponseWriter, r *http.Request) {
+// Get a server's recent console output
+// (GET /api/servers/{id}/console/history)
+func (_ Unimplemented) GetConsoleHistory(w http.ResponseWriter, r *http.Request, id ServerID, params GetConsoleHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListServers(w, r)
-	}))
+// Run a command within a server
+// (POST /api/servers/{id}/exec)
+func (_ Unimplemented) ExecCommand(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Run a command within a server, streaming its output
+// (POST /api/servers/{id}/exec/stream)
+func (_ Unimplemented) StreamExecCommand(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a file within a server's volumes
+// (DELETE /api/servers/{id}/files)
+func (_ Unimplemented) DeleteFile(w http.ResponseWriter, r *http.Request, id ServerID, params DeleteFileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a directory within a server's volumes
+// (GET /api/servers/{id}/files)
+func (_ Unimplemented) ListFiles(w http.ResponseWriter, r *http.Request, id ServerID, params ListFilesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a file within a server's volumes
+// (GET /api/servers/{id}/files/content)
+func (_ Unimplemented) DownloadFile(w http.ResponseWriter, r *http.Request, id ServerID, params DownloadFileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a file within a server's volumes
+// (PUT /api/servers/{id}/files/content)
+func (_ Unimplemented) UploadFile(w http.ResponseWriter, r *http.Request, id ServerID, params UploadFileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a directory within a server's volumes
+// (POST /api/servers/{id}/files/directories)
+func (_ Unimplemented) CreateDirectory(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename or move a file within one of a server's volumes
+// (POST /api/servers/{id}/files/rename)
+func (_ Unimplemented) RenameFile(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Forcefully kill a server
+// (POST /api/servers/{id}/kill)
+func (_ Unimplemented) KillServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a server's logs
+// (GET /api/servers/{id}/logs)
+func (_ Unimplemented) GetServerLogs(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restart a server
+// (POST /api/servers/{id}/restart)
+func (_ Unimplemented) RestartServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a server
+// (POST /api/servers/{id}/start)
+func (_ Unimplemented) StartServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a server's resource usage
+// (GET /api/servers/{id}/stats)
+func (_ Unimplemented) GetServerStats(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a server's recorded resource usage
+// (GET /api/servers/{id}/stats/history)
+func (_ Unimplemented) GetServerStatsHistory(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerStatsHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Gracefully stop a server
+// (POST /api/servers/{id}/stop)
+func (_ Unimplemented) StopServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check for a newer image
+// (GET /api/servers/{id}/update-image)
+func (_ Unimplemented) CheckServerImage(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a server's image
+// (POST /api/servers/{id}/update-image)
+func (_ Unimplemented) UpdateServerImage(w http.ResponseWriter, r *http.Request, id ServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all API tokens
+// (GET /api/tokens)
+func (_ Unimplemented) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new API token
+// (POST /api/tokens)
+func (_ Unimplemented) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an API token
+// (DELETE /api/tokens/{id})
+func (_ Unimplemented) RevokeAPIToken(w http.ResponseWriter, r *http.Request, id TokenID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all users
+// (GET /api/users)
+func (_ Unimplemented) ListUsers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new user
+// (POST /api/users)
+func (_ Unimplemented) CreateUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the user the request is authenticated as
+// (GET /api/users/me)
+func (_ Unimplemented) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a user along with their tokens and grants
+// (DELETE /api/users/{id})
+func (_ Unimplemented) DeleteUser(w http.ResponseWriter, r *http.Request, id UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a user's role on a server
+// (DELETE /api/users/{id}/grants/{serverId})
+func (_ Unimplemented) DeleteServerGrant(w http.ResponseWriter, r *http.Request, id UserID, serverId GrantServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Grant a user a role on a server
+// (PUT /api/users/{id}/grants/{serverId})
+func (_ Unimplemented) SetServerGrant(w http.ResponseWriter, r *http.Request, id UserID, serverId GrantServerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ServerEvents operation middleware
+func (siw *ServerInterfaceWrapper) ServerEvents(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ServerEvents(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListServers operation middleware
+func (siw *ServerInterfaceWrapper) ListServers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServersParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "image" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "image", r.URL.Query(), &params.Image)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
 	handler.ServeHTTP(w, r)
 }
@@ -194,6 +1211,12 @@ func (siw *ServerInterfaceWrapper) ListServers(w http.ResponseWriter, r *http.Re
 // CreateServer operation middleware
 func (siw *ServerInterfaceWrapper) CreateServer(w http.ResponseWriter, r *http.Request) {
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateServer(w, r)
 	}))
@@ -205,6 +1228,48 @@ func (siw *ServerInterfaceWrapper) CreateServer(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
+// DeleteServer operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteServerParams
+
+	// ------------- Optional query parameter "purgeData" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "purgeData", r.URL.Query(), &params.PurgeData)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purgeData", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServer(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetServer operation middleware
 func (siw *ServerInterfaceWrapper) GetServer(w http.ResponseWriter, r *http.Request) {
 
@@ -219,6 +1284,12 @@ func (siw *ServerInterfaceWrapper) GetServer(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetServer(w, r, id)
 	}))
@@ -230,281 +1301,4527 @@ func (siw *ServerInterfaceWrapper) GetServer(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// UpdateServer operation middleware
+func (siw *ServerInterfaceWrapper) UpdateServer(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id ServerID
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateServerParams
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	headers := r.Header
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+		params.IfMatch = &IfMatch
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateServer(w, r, id, params)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
+// ServerConsole operation middleware
+func (siw *ServerInterfaceWrapper) ServerConsole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ServerConsole(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConsoleHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetConsoleHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConsoleHistoryParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConsoleHistory(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExecCommand operation middleware
+func (siw *ServerInterfaceWrapper) ExecCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExecCommand(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamExecCommand operation middleware
+func (siw *ServerInterfaceWrapper) StreamExecCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamExecCommand(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFileParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "recursive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recursive", r.URL.Query(), &params.Recursive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recursive", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFile(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFiles operation middleware
+func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFilesParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFiles(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadFile operation middleware
+func (siw *ServerInterfaceWrapper) DownloadFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadFileParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadFile(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadFile operation middleware
+func (siw *ServerInterfaceWrapper) UploadFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadFileParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadFile(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDirectory operation middleware
+func (siw *ServerInterfaceWrapper) CreateDirectory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDirectory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameFile operation middleware
+func (siw *ServerInterfaceWrapper) RenameFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameFile(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// KillServer operation middleware
+func (siw *ServerInterfaceWrapper) KillServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.KillServer(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServerLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetServerLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServerLogsParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail", r.URL.Query(), &params.Tail)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timestamps" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamps", r.URL.Query(), &params.Timestamps)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timestamps", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stdout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stdout", r.URL.Query(), &params.Stdout)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stdout", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stderr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stderr", r.URL.Query(), &params.Stderr)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stderr", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "download" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "download", r.URL.Query(), &params.Download)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "download", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServerLogs(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestartServer operation middleware
+func (siw *ServerInterfaceWrapper) RestartServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestartServer(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartServer operation middleware
+func (siw *ServerInterfaceWrapper) StartServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartServer(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServerStats operation middleware
+func (siw *ServerInterfaceWrapper) GetServerStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServerStatsParams
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServerStats(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServerStatsHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetServerStatsHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServerStatsHistoryParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServerStatsHistory(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopServer operation middleware
+func (siw *ServerInterfaceWrapper) StopServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopServer(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckServerImage operation middleware
+func (siw *ServerInterfaceWrapper) CheckServerImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckServerImage(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateServerImage operation middleware
+func (siw *ServerInterfaceWrapper) UpdateServerImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateServerImage(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAPITokens operation middleware
+func (siw *ServerInterfaceWrapper) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAPITokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TokenID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAPIToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteServerGrant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServerGrant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId GrantServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServerGrant(w, r, id, serverId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetServerGrant operation middleware
+func (siw *ServerInterfaceWrapper) SetServerGrant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "serverId" -------------
+	var serverId GrantServerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "serverId", chi.URLParam(r, "serverId"), &serverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serverId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerGrant(w, r, id, serverId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
 	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/events", wrapper.ServerEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers", wrapper.ListServers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers", wrapper.CreateServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/servers/{id}", wrapper.DeleteServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}", wrapper.GetServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/servers/{id}", wrapper.UpdateServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/console", wrapper.ServerConsole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/console/history", wrapper.GetConsoleHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/exec", wrapper.ExecCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/exec/stream", wrapper.StreamExecCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/servers/{id}/files", wrapper.DeleteFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/files", wrapper.ListFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/files/content", wrapper.DownloadFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/servers/{id}/files/content", wrapper.UploadFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/files/directories", wrapper.CreateDirectory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/files/rename", wrapper.RenameFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/kill", wrapper.KillServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/logs", wrapper.GetServerLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/restart", wrapper.RestartServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/start", wrapper.StartServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/stats", wrapper.GetServerStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/stats/history", wrapper.GetServerStatsHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/stop", wrapper.StopServer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/servers/{id}/update-image", wrapper.CheckServerImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/servers/{id}/update-image", wrapper.UpdateServerImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/tokens", wrapper.ListAPITokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/tokens", wrapper.CreateAPIToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/tokens/{id}", wrapper.RevokeAPIToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/users", wrapper.ListUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/users", wrapper.CreateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/users/me", wrapper.GetCurrentUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/users/{id}", wrapper.DeleteUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/users/{id}/grants/{serverId}", wrapper.DeleteServerGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/users/{id}/grants/{serverId}", wrapper.SetServerGrant)
+	})
+
+	return r
+}
+
+type ForbiddenApplicationProblemPlusJSONResponse Error
+
+type UnauthorizedApplicationProblemPlusJSONResponse Error
+
+type ServerEventsRequestObject struct {
+}
+
+type ServerEventsResponseObject interface {
+	VisitServerEventsResponse(w http.ResponseWriter) error
+}
+
+type ServerEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ServerEvents200TexteventStreamResponse) VisitServerEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ServerEvents401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ServerEvents401ApplicationProblemPlusJSONResponse) VisitServerEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServerEvents500ApplicationProblemPlusJSONResponse Error
+
+func (response ServerEvents500ApplicationProblemPlusJSONResponse) VisitServerEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServersRequestObject struct {
+	Params ListServersParams
+}
+
+type ListServersResponseObject interface {
+	VisitListServersResponse(w http.ResponseWriter) error
+}
+
+type ListServers200JSONResponse ServersResponse
+
+func (response ListServers200JSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServers400ApplicationProblemPlusJSONResponse Error
+
+func (response ListServers400ApplicationProblemPlusJSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListServers401ApplicationProblemPlusJSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServers500ApplicationProblemPlusJSONResponse Error
+
+func (response ListServers500ApplicationProblemPlusJSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServerRequestObject struct {
+	Body *CreateServerJSONRequestBody
+}
+
+type CreateServerResponseObject interface {
+	VisitCreateServerResponse(w http.ResponseWriter) error
+}
+
+type CreateServer201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateServer201JSONResponse struct {
+	Body    ServerResponse
+	Headers CreateServer201ResponseHeaders
+}
+
+func (response CreateServer201JSONResponse) VisitCreateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateServer400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateServer400ApplicationProblemPlusJSONResponse) VisitCreateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateServer401ApplicationProblemPlusJSONResponse) VisitCreateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateServer403ApplicationProblemPlusJSONResponse) VisitCreateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServer500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateServer500ApplicationProblemPlusJSONResponse) VisitCreateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServerRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params DeleteServerParams
+}
+
+type DeleteServerResponseObject interface {
+	VisitDeleteServerResponse(w http.ResponseWriter) error
+}
+
+type DeleteServer204Response struct {
+}
+
+func (response DeleteServer204Response) VisitDeleteServerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServer401ApplicationProblemPlusJSONResponse) VisitDeleteServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServer403ApplicationProblemPlusJSONResponse) VisitDeleteServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServer404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteServer404ApplicationProblemPlusJSONResponse) VisitDeleteServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServer500ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteServer500ApplicationProblemPlusJSONResponse) VisitDeleteServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetServerResponseObject interface {
+	VisitGetServerResponse(w http.ResponseWriter) error
+}
+
+type GetServer200ResponseHeaders struct {
+	ETag string
+}
+
+type GetServer200JSONResponse struct {
+	Body    ServerResponse
+	Headers GetServer200ResponseHeaders
+}
+
+func (response GetServer200JSONResponse) VisitGetServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetServer401ApplicationProblemPlusJSONResponse) VisitGetServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServer404ApplicationProblemPlusJSONResponse Error
+
+func (response GetServer404ApplicationProblemPlusJSONResponse) VisitGetServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServer500ApplicationProblemPlusJSONResponse Error
+
+func (response GetServer500ApplicationProblemPlusJSONResponse) VisitGetServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServerRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params UpdateServerParams
+	Body   *UpdateServerJSONRequestBody
+}
+
+type UpdateServerResponseObject interface {
+	VisitUpdateServerResponse(w http.ResponseWriter) error
+}
+
+type UpdateServer200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateServer200JSONResponse struct {
+	Body    ServerResponse
+	Headers UpdateServer200ResponseHeaders
+}
+
+func (response UpdateServer200JSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateServer400ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServer400ApplicationProblemPlusJSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateServer401ApplicationProblemPlusJSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateServer403ApplicationProblemPlusJSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServer404ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServer404ApplicationProblemPlusJSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServer412ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServer412ApplicationProblemPlusJSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServer428ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServer428ApplicationProblemPlusJSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServer500ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServer500ApplicationProblemPlusJSONResponse) VisitUpdateServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServerConsoleRequestObject struct {
+	Id ServerID `json:"id"`
+}
+
+type ServerConsoleResponseObject interface {
+	VisitServerConsoleResponse(w http.ResponseWriter) error
+}
+
+type ServerConsole101Response struct {
+}
+
+func (response ServerConsole101Response) VisitServerConsoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(101)
+	return nil
+}
+
+type ServerConsole401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ServerConsole401ApplicationProblemPlusJSONResponse) VisitServerConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServerConsole403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ServerConsole403ApplicationProblemPlusJSONResponse) VisitServerConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServerConsole404ApplicationProblemPlusJSONResponse Error
+
+func (response ServerConsole404ApplicationProblemPlusJSONResponse) VisitServerConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ServerConsole500ApplicationProblemPlusJSONResponse Error
+
+func (response ServerConsole500ApplicationProblemPlusJSONResponse) VisitServerConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConsoleHistoryRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params GetConsoleHistoryParams
+}
+
+type GetConsoleHistoryResponseObject interface {
+	VisitGetConsoleHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetConsoleHistory200JSONResponse ConsoleHistoryResponse
+
+func (response GetConsoleHistory200JSONResponse) VisitGetConsoleHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConsoleHistory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetConsoleHistory401ApplicationProblemPlusJSONResponse) VisitGetConsoleHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConsoleHistory403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetConsoleHistory403ApplicationProblemPlusJSONResponse) VisitGetConsoleHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConsoleHistory404ApplicationProblemPlusJSONResponse Error
+
+func (response GetConsoleHistory404ApplicationProblemPlusJSONResponse) VisitGetConsoleHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConsoleHistory500ApplicationProblemPlusJSONResponse Error
+
+func (response GetConsoleHistory500ApplicationProblemPlusJSONResponse) VisitGetConsoleHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecCommandRequestObject struct {
+	Id   ServerID `json:"id"`
+	Body *ExecCommandJSONRequestBody
+}
+
+type ExecCommandResponseObject interface {
+	VisitExecCommandResponse(w http.ResponseWriter) error
+}
+
+type ExecCommand200JSONResponse ExecResult
+
+func (response ExecCommand200JSONResponse) VisitExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecCommand400ApplicationProblemPlusJSONResponse Error
+
+func (response ExecCommand400ApplicationProblemPlusJSONResponse) VisitExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecCommand401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ExecCommand401ApplicationProblemPlusJSONResponse) VisitExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecCommand403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ExecCommand403ApplicationProblemPlusJSONResponse) VisitExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecCommand404ApplicationProblemPlusJSONResponse Error
+
+func (response ExecCommand404ApplicationProblemPlusJSONResponse) VisitExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecCommand409ApplicationProblemPlusJSONResponse Error
+
+func (response ExecCommand409ApplicationProblemPlusJSONResponse) VisitExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExecCommand500ApplicationProblemPlusJSONResponse Error
+
+func (response ExecCommand500ApplicationProblemPlusJSONResponse) VisitExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamExecCommandRequestObject struct {
+	Id   ServerID `json:"id"`
+	Body *StreamExecCommandJSONRequestBody
+}
+
+type StreamExecCommandResponseObject interface {
+	VisitStreamExecCommandResponse(w http.ResponseWriter) error
+}
+
+type StreamExecCommand200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamExecCommand200ApplicationxNdjsonResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamExecCommand400ApplicationProblemPlusJSONResponse Error
+
+func (response StreamExecCommand400ApplicationProblemPlusJSONResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamExecCommand401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response StreamExecCommand401ApplicationProblemPlusJSONResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamExecCommand403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response StreamExecCommand403ApplicationProblemPlusJSONResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamExecCommand404ApplicationProblemPlusJSONResponse Error
+
+func (response StreamExecCommand404ApplicationProblemPlusJSONResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamExecCommand409ApplicationProblemPlusJSONResponse Error
+
+func (response StreamExecCommand409ApplicationProblemPlusJSONResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamExecCommand500ApplicationProblemPlusJSONResponse Error
+
+func (response StreamExecCommand500ApplicationProblemPlusJSONResponse) VisitStreamExecCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFileRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params DeleteFileParams
+}
+
+type DeleteFileResponseObject interface {
+	VisitDeleteFileResponse(w http.ResponseWriter) error
+}
+
+type DeleteFile204Response struct {
+}
+
+func (response DeleteFile204Response) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteFile400ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteFile400ApplicationProblemPlusJSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFile401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteFile401ApplicationProblemPlusJSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFile403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteFile403ApplicationProblemPlusJSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFile404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteFile404ApplicationProblemPlusJSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFile409ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteFile409ApplicationProblemPlusJSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFile500ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteFile500ApplicationProblemPlusJSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFilesRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params ListFilesParams
+}
+
+type ListFilesResponseObject interface {
+	VisitListFilesResponse(w http.ResponseWriter) error
+}
+
+type ListFiles200JSONResponse FilesResponse
+
+func (response ListFiles200JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFiles400ApplicationProblemPlusJSONResponse Error
+
+func (response ListFiles400ApplicationProblemPlusJSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFiles401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListFiles401ApplicationProblemPlusJSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFiles403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListFiles403ApplicationProblemPlusJSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFiles404ApplicationProblemPlusJSONResponse Error
+
+func (response ListFiles404ApplicationProblemPlusJSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFiles500ApplicationProblemPlusJSONResponse Error
+
+func (response ListFiles500ApplicationProblemPlusJSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFileRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params DownloadFileParams
+}
+
+type DownloadFileResponseObject interface {
+	VisitDownloadFileResponse(w http.ResponseWriter) error
+}
+
+type DownloadFile200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadFile200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       DownloadFile200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadFile200ApplicationoctetStreamResponse) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadFile400ApplicationProblemPlusJSONResponse Error
+
+func (response DownloadFile400ApplicationProblemPlusJSONResponse) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFile401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DownloadFile401ApplicationProblemPlusJSONResponse) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFile403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DownloadFile403ApplicationProblemPlusJSONResponse) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFile404ApplicationProblemPlusJSONResponse Error
+
+func (response DownloadFile404ApplicationProblemPlusJSONResponse) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadFile500ApplicationProblemPlusJSONResponse Error
+
+func (response DownloadFile500ApplicationProblemPlusJSONResponse) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadFileRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params UploadFileParams
+	Body   io.Reader
+}
+
+type UploadFileResponseObject interface {
+	VisitUploadFileResponse(w http.ResponseWriter) error
+}
+
+type UploadFile200JSONResponse FileResponse
+
+func (response UploadFile200JSONResponse) VisitUploadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadFile400ApplicationProblemPlusJSONResponse Error
+
+func (response UploadFile400ApplicationProblemPlusJSONResponse) VisitUploadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadFile401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UploadFile401ApplicationProblemPlusJSONResponse) VisitUploadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadFile403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UploadFile403ApplicationProblemPlusJSONResponse) VisitUploadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadFile404ApplicationProblemPlusJSONResponse Error
+
+func (response UploadFile404ApplicationProblemPlusJSONResponse) VisitUploadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadFile500ApplicationProblemPlusJSONResponse Error
+
+func (response UploadFile500ApplicationProblemPlusJSONResponse) VisitUploadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectoryRequestObject struct {
+	Id   ServerID `json:"id"`
+	Body *CreateDirectoryJSONRequestBody
+}
+
+type CreateDirectoryResponseObject interface {
+	VisitCreateDirectoryResponse(w http.ResponseWriter) error
+}
+
+type CreateDirectory201JSONResponse FileResponse
+
+func (response CreateDirectory201JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateDirectory400ApplicationProblemPlusJSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDirectory401ApplicationProblemPlusJSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateDirectory403ApplicationProblemPlusJSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory404ApplicationProblemPlusJSONResponse Error
+
+func (response CreateDirectory404ApplicationProblemPlusJSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory409ApplicationProblemPlusJSONResponse Error
+
+func (response CreateDirectory409ApplicationProblemPlusJSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateDirectory500ApplicationProblemPlusJSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFileRequestObject struct {
+	Id   ServerID `json:"id"`
+	Body *RenameFileJSONRequestBody
+}
+
+type RenameFileResponseObject interface {
+	VisitRenameFileResponse(w http.ResponseWriter) error
+}
+
+type RenameFile200JSONResponse FileResponse
+
+func (response RenameFile200JSONResponse) VisitRenameFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFile400ApplicationProblemPlusJSONResponse Error
+
+func (response RenameFile400ApplicationProblemPlusJSONResponse) VisitRenameFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFile401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RenameFile401ApplicationProblemPlusJSONResponse) VisitRenameFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFile403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RenameFile403ApplicationProblemPlusJSONResponse) VisitRenameFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFile404ApplicationProblemPlusJSONResponse Error
+
+func (response RenameFile404ApplicationProblemPlusJSONResponse) VisitRenameFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFile409ApplicationProblemPlusJSONResponse Error
+
+func (response RenameFile409ApplicationProblemPlusJSONResponse) VisitRenameFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameFile500ApplicationProblemPlusJSONResponse Error
+
+func (response RenameFile500ApplicationProblemPlusJSONResponse) VisitRenameFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KillServerRequestObject struct {
+	Id ServerID `json:"id"`
+}
+
+type KillServerResponseObject interface {
+	VisitKillServerResponse(w http.ResponseWriter) error
+}
+
+type KillServer200JSONResponse ServerResponse
+
+func (response KillServer200JSONResponse) VisitKillServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KillServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response KillServer401ApplicationProblemPlusJSONResponse) VisitKillServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KillServer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response KillServer403ApplicationProblemPlusJSONResponse) VisitKillServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KillServer404ApplicationProblemPlusJSONResponse Error
+
+func (response KillServer404ApplicationProblemPlusJSONResponse) VisitKillServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KillServer409ApplicationProblemPlusJSONResponse Error
+
+func (response KillServer409ApplicationProblemPlusJSONResponse) VisitKillServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type KillServer500ApplicationProblemPlusJSONResponse Error
+
+func (response KillServer500ApplicationProblemPlusJSONResponse) VisitKillServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerLogsRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params GetServerLogsParams
+}
+
+type GetServerLogsResponseObject interface {
+	VisitGetServerLogsResponse(w http.ResponseWriter) error
+}
+
+type GetServerLogs200ApplicationgzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetServerLogs200ApplicationgzipResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetServerLogs200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetServerLogs200ApplicationxNdjsonResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetServerLogs400ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerLogs400ApplicationProblemPlusJSONResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerLogs401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetServerLogs401ApplicationProblemPlusJSONResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerLogs403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetServerLogs403ApplicationProblemPlusJSONResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerLogs404ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerLogs404ApplicationProblemPlusJSONResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerLogs409ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerLogs409ApplicationProblemPlusJSONResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerLogs500ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerLogs500ApplicationProblemPlusJSONResponse) VisitGetServerLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartServerRequestObject struct {
+	Id ServerID `json:"id"`
+}
+
+type RestartServerResponseObject interface {
+	VisitRestartServerResponse(w http.ResponseWriter) error
+}
+
+type RestartServer200JSONResponse ServerResponse
+
+func (response RestartServer200JSONResponse) VisitRestartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RestartServer401ApplicationProblemPlusJSONResponse) VisitRestartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartServer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestartServer403ApplicationProblemPlusJSONResponse) VisitRestartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartServer404ApplicationProblemPlusJSONResponse Error
+
+func (response RestartServer404ApplicationProblemPlusJSONResponse) VisitRestartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartServer409ApplicationProblemPlusJSONResponse Error
+
+func (response RestartServer409ApplicationProblemPlusJSONResponse) VisitRestartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestartServer500ApplicationProblemPlusJSONResponse Error
+
+func (response RestartServer500ApplicationProblemPlusJSONResponse) VisitRestartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartServerRequestObject struct {
+	Id ServerID `json:"id"`
+}
+
+type StartServerResponseObject interface {
+	VisitStartServerResponse(w http.ResponseWriter) error
+}
+
+type StartServer200JSONResponse ServerResponse
+
+func (response StartServer200JSONResponse) VisitStartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response StartServer401ApplicationProblemPlusJSONResponse) VisitStartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartServer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response StartServer403ApplicationProblemPlusJSONResponse) VisitStartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartServer404ApplicationProblemPlusJSONResponse Error
+
+func (response StartServer404ApplicationProblemPlusJSONResponse) VisitStartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartServer409ApplicationProblemPlusJSONResponse Error
+
+func (response StartServer409ApplicationProblemPlusJSONResponse) VisitStartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartServer500ApplicationProblemPlusJSONResponse Error
+
+func (response StartServer500ApplicationProblemPlusJSONResponse) VisitStartServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStatsRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params GetServerStatsParams
+}
+
+type GetServerStatsResponseObject interface {
+	VisitGetServerStatsResponse(w http.ResponseWriter) error
+}
+
+type GetServerStats200JSONResponse ServerStatsResponse
+
+func (response GetServerStats200JSONResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStats200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetServerStats200ApplicationxNdjsonResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetServerStats401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetServerStats401ApplicationProblemPlusJSONResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStats403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetServerStats403ApplicationProblemPlusJSONResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStats404ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerStats404ApplicationProblemPlusJSONResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStats409ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerStats409ApplicationProblemPlusJSONResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStats500ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerStats500ApplicationProblemPlusJSONResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStatsHistoryRequestObject struct {
+	Id     ServerID `json:"id"`
+	Params GetServerStatsHistoryParams
+}
+
+type GetServerStatsHistoryResponseObject interface {
+	VisitGetServerStatsHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetServerStatsHistory200JSONResponse StatsHistoryResponse
+
+func (response GetServerStatsHistory200JSONResponse) VisitGetServerStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStatsHistory400ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerStatsHistory400ApplicationProblemPlusJSONResponse) VisitGetServerStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStatsHistory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetServerStatsHistory401ApplicationProblemPlusJSONResponse) VisitGetServerStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStatsHistory403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetServerStatsHistory403ApplicationProblemPlusJSONResponse) VisitGetServerStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStatsHistory404ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerStatsHistory404ApplicationProblemPlusJSONResponse) VisitGetServerStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServerStatsHistory500ApplicationProblemPlusJSONResponse Error
+
+func (response GetServerStatsHistory500ApplicationProblemPlusJSONResponse) VisitGetServerStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopServerRequestObject struct {
+	Id ServerID `json:"id"`
+}
+
+type StopServerResponseObject interface {
+	VisitStopServerResponse(w http.ResponseWriter) error
+}
+
+type StopServer200JSONResponse ServerResponse
+
+func (response StopServer200JSONResponse) VisitStopServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopServer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response StopServer401ApplicationProblemPlusJSONResponse) VisitStopServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopServer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response StopServer403ApplicationProblemPlusJSONResponse) VisitStopServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopServer404ApplicationProblemPlusJSONResponse Error
+
+func (response StopServer404ApplicationProblemPlusJSONResponse) VisitStopServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopServer409ApplicationProblemPlusJSONResponse Error
+
+func (response StopServer409ApplicationProblemPlusJSONResponse) VisitStopServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopServer500ApplicationProblemPlusJSONResponse Error
+
+func (response StopServer500ApplicationProblemPlusJSONResponse) VisitStopServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckServerImageRequestObject struct {
+	Id ServerID `json:"id"`
+}
+
+type CheckServerImageResponseObject interface {
+	VisitCheckServerImageResponse(w http.ResponseWriter) error
+}
+
+type CheckServerImage200JSONResponse ImageUpdateResponse
+
+func (response CheckServerImage200JSONResponse) VisitCheckServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckServerImage401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CheckServerImage401ApplicationProblemPlusJSONResponse) VisitCheckServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckServerImage403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CheckServerImage403ApplicationProblemPlusJSONResponse) VisitCheckServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckServerImage404ApplicationProblemPlusJSONResponse Error
+
+func (response CheckServerImage404ApplicationProblemPlusJSONResponse) VisitCheckServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckServerImage409ApplicationProblemPlusJSONResponse Error
+
+func (response CheckServerImage409ApplicationProblemPlusJSONResponse) VisitCheckServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckServerImage500ApplicationProblemPlusJSONResponse Error
+
+func (response CheckServerImage500ApplicationProblemPlusJSONResponse) VisitCheckServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServerImageRequestObject struct {
+	Id ServerID `json:"id"`
+}
+
+type UpdateServerImageResponseObject interface {
+	VisitUpdateServerImageResponse(w http.ResponseWriter) error
+}
+
+type UpdateServerImage200JSONResponse ServerImageUpdateResponse
+
+func (response UpdateServerImage200JSONResponse) VisitUpdateServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServerImage401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateServerImage401ApplicationProblemPlusJSONResponse) VisitUpdateServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServerImage403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateServerImage403ApplicationProblemPlusJSONResponse) VisitUpdateServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServerImage404ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServerImage404ApplicationProblemPlusJSONResponse) VisitUpdateServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServerImage409ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServerImage409ApplicationProblemPlusJSONResponse) VisitUpdateServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServerImage500ApplicationProblemPlusJSONResponse Error
+
+func (response UpdateServerImage500ApplicationProblemPlusJSONResponse) VisitUpdateServerImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAPITokensRequestObject struct {
+}
+
+type ListAPITokensResponseObject interface {
+	VisitListAPITokensResponse(w http.ResponseWriter) error
+}
+
+type ListAPITokens200JSONResponse APITokensResponse
+
+func (response ListAPITokens200JSONResponse) VisitListAPITokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAPITokens401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListAPITokens401ApplicationProblemPlusJSONResponse) VisitListAPITokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAPITokens500ApplicationProblemPlusJSONResponse Error
+
+func (response ListAPITokens500ApplicationProblemPlusJSONResponse) VisitListAPITokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPITokenRequestObject struct {
+	Body *CreateAPITokenJSONRequestBody
+}
+
+type CreateAPITokenResponseObject interface {
+	VisitCreateAPITokenResponse(w http.ResponseWriter) error
+}
+
+type CreateAPIToken201JSONResponse NewAPITokenResponse
+
+func (response CreateAPIToken201JSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIToken400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateAPIToken400ApplicationProblemPlusJSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIToken401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAPIToken401ApplicationProblemPlusJSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIToken403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateAPIToken403ApplicationProblemPlusJSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIToken500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateAPIToken500ApplicationProblemPlusJSONResponse) VisitCreateAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPITokenRequestObject struct {
+	Id TokenID `json:"id"`
+}
+
+type RevokeAPITokenResponseObject interface {
+	VisitRevokeAPITokenResponse(w http.ResponseWriter) error
+}
+
+type RevokeAPIToken204Response struct {
+}
+
+func (response RevokeAPIToken204Response) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeAPIToken401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeAPIToken401ApplicationProblemPlusJSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIToken403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeAPIToken403ApplicationProblemPlusJSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIToken404ApplicationProblemPlusJSONResponse Error
+
+func (response RevokeAPIToken404ApplicationProblemPlusJSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIToken500ApplicationProblemPlusJSONResponse Error
+
+func (response RevokeAPIToken500ApplicationProblemPlusJSONResponse) VisitRevokeAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsersRequestObject struct {
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(w http.ResponseWriter) error
+}
+
+type ListUsers200JSONResponse UsersResponse
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListUsers401ApplicationProblemPlusJSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListUsers403ApplicationProblemPlusJSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers500ApplicationProblemPlusJSONResponse Error
+
+func (response ListUsers500ApplicationProblemPlusJSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201JSONResponse UserResponse
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser400ApplicationProblemPlusJSONResponse Error
+
+func (response CreateUser400ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateUser401ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateUser403ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser409ApplicationProblemPlusJSONResponse Error
+
+func (response CreateUser409ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser500ApplicationProblemPlusJSONResponse Error
+
+func (response CreateUser500ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUserRequestObject struct {
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse UserResponse
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetCurrentUser401ApplicationProblemPlusJSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser500ApplicationProblemPlusJSONResponse Error
+
+func (response GetCurrentUser500ApplicationProblemPlusJSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserRequestObject struct {
+	Id UserID `json:"id"`
+}
+
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteUser204Response struct {
+}
+
+func (response DeleteUser204Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUser401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUser401ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUser403ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteUser404ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser500ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteUser500ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServerGrantRequestObject struct {
+	Id       UserID        `json:"id"`
+	ServerId GrantServerID `json:"serverId"`
+}
+
+type DeleteServerGrantResponseObject interface {
+	VisitDeleteServerGrantResponse(w http.ResponseWriter) error
+}
+
+type DeleteServerGrant200JSONResponse UserResponse
+
+func (response DeleteServerGrant200JSONResponse) VisitDeleteServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServerGrant401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServerGrant401ApplicationProblemPlusJSONResponse) VisitDeleteServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServerGrant403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServerGrant403ApplicationProblemPlusJSONResponse) VisitDeleteServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServerGrant404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteServerGrant404ApplicationProblemPlusJSONResponse) VisitDeleteServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServerGrant500ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteServerGrant500ApplicationProblemPlusJSONResponse) VisitDeleteServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetServerGrantRequestObject struct {
+	Id       UserID        `json:"id"`
+	ServerId GrantServerID `json:"serverId"`
+	Body     *SetServerGrantJSONRequestBody
+}
+
+type SetServerGrantResponseObject interface {
+	VisitSetServerGrantResponse(w http.ResponseWriter) error
+}
+
+type SetServerGrant200JSONResponse UserResponse
+
+func (response SetServerGrant200JSONResponse) VisitSetServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetServerGrant400ApplicationProblemPlusJSONResponse Error
+
+func (response SetServerGrant400ApplicationProblemPlusJSONResponse) VisitSetServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetServerGrant401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SetServerGrant401ApplicationProblemPlusJSONResponse) VisitSetServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetServerGrant403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SetServerGrant403ApplicationProblemPlusJSONResponse) VisitSetServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetServerGrant404ApplicationProblemPlusJSONResponse Error
+
+func (response SetServerGrant404ApplicationProblemPlusJSONResponse) VisitSetServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetServerGrant500ApplicationProblemPlusJSONResponse Error
+
+func (response SetServerGrant500ApplicationProblemPlusJSONResponse) VisitSetServerGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Stream status changes across all servers
+	// (GET /api/events)
+	ServerEvents(ctx context.Context, request ServerEventsRequestObject) (ServerEventsResponseObject, error)
+	// List all servers
+	// (GET /api/servers)
+	ListServers(ctx context.Context, request ListServersRequestObject) (ListServersResponseObject, error)
+	// Create a new server
+	// (POST /api/servers)
+	CreateServer(ctx context.Context, request CreateServerRequestObject) (CreateServerResponseObject, error)
+	// Delete a server
+	// (DELETE /api/servers/{id})
+	DeleteServer(ctx context.Context, request DeleteServerRequestObject) (DeleteServerResponseObject, error)
+	// Get a server by ID
+	// (GET /api/servers/{id})
+	GetServer(ctx context.Context, request GetServerRequestObject) (GetServerResponseObject, error)
+	// Update a server's configuration
+	// (PUT /api/servers/{id})
+	UpdateServer(ctx context.Context, request UpdateServerRequestObject) (UpdateServerResponseObject, error)
+	// Open an interactive console to a server
+	// (GET /api/servers/{id}/console)
+	ServerConsole(ctx context.Context, request ServerConsoleRequestObject) (ServerConsoleResponseObject, error)
+	// Get a server's recent console output
+	// (GET /api/servers/{id}/console/history)
+	GetConsoleHistory(ctx context.Context, request GetConsoleHistoryRequestObject) (GetConsoleHistoryResponseObject, error)
+	// Run a command within a server
+	// (POST /api/servers/{id}/exec)
+	ExecCommand(ctx context.Context, request ExecCommandRequestObject) (ExecCommandResponseObject, error)
+	// Run a command within a server, streaming its output
+	// (POST /api/servers/{id}/exec/stream)
+	StreamExecCommand(ctx context.Context, request StreamExecCommandRequestObject) (StreamExecCommandResponseObject, error)
+	// Delete a file within a server's volumes
+	// (DELETE /api/servers/{id}/files)
+	DeleteFile(ctx context.Context, request DeleteFileRequestObject) (DeleteFileResponseObject, error)
+	// List a directory within a server's volumes
+	// (GET /api/servers/{id}/files)
+	ListFiles(ctx context.Context, request ListFilesRequestObject) (ListFilesResponseObject, error)
+	// Download a file within a server's volumes
+	// (GET /api/servers/{id}/files/content)
+	DownloadFile(ctx context.Context, request DownloadFileRequestObject) (DownloadFileResponseObject, error)
+	// Upload a file within a server's volumes
+	// (PUT /api/servers/{id}/files/content)
+	UploadFile(ctx context.Context, request UploadFileRequestObject) (UploadFileResponseObject, error)
+	// Create a directory within a server's volumes
+	// (POST /api/servers/{id}/files/directories)
+	CreateDirectory(ctx context.Context, request CreateDirectoryRequestObject) (CreateDirectoryResponseObject, error)
+	// Rename or move a file within one of a server's volumes
+	// (POST /api/servers/{id}/files/rename)
+	RenameFile(ctx context.Context, request RenameFileRequestObject) (RenameFileResponseObject, error)
+	// Forcefully kill a server
+	// (POST /api/servers/{id}/kill)
+	KillServer(ctx context.Context, request KillServerRequestObject) (KillServerResponseObject, error)
+	// Read a server's logs
+	// (GET /api/servers/{id}/logs)
+	GetServerLogs(ctx context.Context, request GetServerLogsRequestObject) (GetServerLogsResponseObject, error)
+	// Restart a server
+	// (POST /api/servers/{id}/restart)
+	RestartServer(ctx context.Context, request RestartServerRequestObject) (RestartServerResponseObject, error)
+	// Start a server
+	// (POST /api/servers/{id}/start)
+	StartServer(ctx context.Context, request StartServerRequestObject) (StartServerResponseObject, error)
+	// Read a server's resource usage
+	// (GET /api/servers/{id}/stats)
+	GetServerStats(ctx context.Context, request GetServerStatsRequestObject) (GetServerStatsResponseObject, error)
+	// Read a server's recorded resource usage
+	// (GET /api/servers/{id}/stats/history)
+	GetServerStatsHistory(ctx context.Context, request GetServerStatsHistoryRequestObject) (GetServerStatsHistoryResponseObject, error)
+	// Gracefully stop a server
+	// (POST /api/servers/{id}/stop)
+	StopServer(ctx context.Context, request StopServerRequestObject) (StopServerResponseObject, error)
+	// Check for a newer image
+	// (GET /api/servers/{id}/update-image)
+	CheckServerImage(ctx context.Context, request CheckServerImageRequestObject) (CheckServerImageResponseObject, error)
+	// Update a server's image
+	// (POST /api/servers/{id}/update-image)
+	UpdateServerImage(ctx context.Context, request UpdateServerImageRequestObject) (UpdateServerImageResponseObject, error)
+	// List all API tokens
+	// (GET /api/tokens)
+	ListAPITokens(ctx context.Context, request ListAPITokensRequestObject) (ListAPITokensResponseObject, error)
+	// Create a new API token
+	// (POST /api/tokens)
+	CreateAPIToken(ctx context.Context, request CreateAPITokenRequestObject) (CreateAPITokenResponseObject, error)
+	// Revoke an API token
+	// (DELETE /api/tokens/{id})
+	RevokeAPIToken(ctx context.Context, request RevokeAPITokenRequestObject) (RevokeAPITokenResponseObject, error)
+	// List all users
+	// (GET /api/users)
+	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
+	// Create a new user
+	// (POST /api/users)
+	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+	// Get the user the request is authenticated as
+	// (GET /api/users/me)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// Delete a user along with their tokens and grants
+	// (DELETE /api/users/{id})
+	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
+	// Revoke a user's role on a server
+	// (DELETE /api/users/{id}/grants/{serverId})
+	DeleteServerGrant(ctx context.Context, request DeleteServerGrantRequestObject) (DeleteServerGrantResponseObject, error)
+	// Grant a user a role on a server
+	// (PUT /api/users/{id}/grants/{serverId})
+	SetServerGrant(ctx context.Context, request SetServerGrantRequestObject) (SetServerGrantResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ServerEvents operation middleware
+func (sh *strictHandler) ServerEvents(w http.ResponseWriter, r *http.Request) {
+	var request ServerEventsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ServerEvents(ctx, request.(ServerEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ServerEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ServerEventsResponseObject); ok {
+		if err := validResponse.VisitServerEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListServers operation middleware
+func (sh *strictHandler) ListServers(w http.ResponseWriter, r *http.Request, params ListServersParams) {
+	var request ListServersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListServers(ctx, request.(ListServersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListServers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListServersResponseObject); ok {
+		if err := validResponse.VisitListServersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateServer operation middleware
+func (sh *strictHandler) CreateServer(w http.ResponseWriter, r *http.Request) {
+	var request CreateServerRequestObject
+
+	var body CreateServerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateServer(ctx, request.(CreateServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateServerResponseObject); ok {
+		if err := validResponse.VisitCreateServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteServer operation middleware
+func (sh *strictHandler) DeleteServer(w http.ResponseWriter, r *http.Request, id ServerID, params DeleteServerParams) {
+	var request DeleteServerRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServer(ctx, request.(DeleteServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteServerResponseObject); ok {
+		if err := validResponse.VisitDeleteServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServer operation middleware
+func (sh *strictHandler) GetServer(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetServerRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServer(ctx, request.(GetServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServerResponseObject); ok {
+		if err := validResponse.VisitGetServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateServer operation middleware
+func (sh *strictHandler) UpdateServer(w http.ResponseWriter, r *http.Request, id ServerID, params UpdateServerParams) {
+	var request UpdateServerRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateServerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateServer(ctx, request.(UpdateServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateServerResponseObject); ok {
+		if err := validResponse.VisitUpdateServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ServerConsole operation middleware
+func (sh *strictHandler) ServerConsole(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request ServerConsoleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ServerConsole(ctx, request.(ServerConsoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ServerConsole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ServerConsoleResponseObject); ok {
+		if err := validResponse.VisitServerConsoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetConsoleHistory operation middleware
+func (sh *strictHandler) GetConsoleHistory(w http.ResponseWriter, r *http.Request, id ServerID, params GetConsoleHistoryParams) {
+	var request GetConsoleHistoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConsoleHistory(ctx, request.(GetConsoleHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConsoleHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConsoleHistoryResponseObject); ok {
+		if err := validResponse.VisitGetConsoleHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExecCommand operation middleware
+func (sh *strictHandler) ExecCommand(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request ExecCommandRequestObject
+
+	request.Id = id
+
+	var body ExecCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExecCommand(ctx, request.(ExecCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExecCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExecCommandResponseObject); ok {
+		if err := validResponse.VisitExecCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamExecCommand operation middleware
+func (sh *strictHandler) StreamExecCommand(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request StreamExecCommandRequestObject
+
+	request.Id = id
+
+	var body StreamExecCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamExecCommand(ctx, request.(StreamExecCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamExecCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamExecCommandResponseObject); ok {
+		if err := validResponse.VisitStreamExecCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteFile operation middleware
+func (sh *strictHandler) DeleteFile(w http.ResponseWriter, r *http.Request, id ServerID, params DeleteFileParams) {
+	var request DeleteFileRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFile(ctx, request.(DeleteFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteFileResponseObject); ok {
+		if err := validResponse.VisitDeleteFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListFiles operation middleware
+func (sh *strictHandler) ListFiles(w http.ResponseWriter, r *http.Request, id ServerID, params ListFilesParams) {
+	var request ListFilesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFiles(ctx, request.(ListFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFiles")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFilesResponseObject); ok {
+		if err := validResponse.VisitListFilesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// DownloadFile operation middleware
+func (sh *strictHandler) DownloadFile(w http.ResponseWriter, r *http.Request, id ServerID, params DownloadFileParams) {
+	var request DownloadFileRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadFile(ctx, request.(DownloadFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadFileResponseObject); ok {
+		if err := validResponse.VisitDownloadFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// UploadFile operation middleware
+func (sh *strictHandler) UploadFile(w http.ResponseWriter, r *http.Request, id ServerID, params UploadFileParams) {
+	var request UploadFileRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadFile(ctx, request.(UploadFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadFileResponseObject); ok {
+		if err := validResponse.VisitUploadFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateDirectory operation middleware
+func (sh *strictHandler) CreateDirectory(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request CreateDirectoryRequestObject
+
+	request.Id = id
+
+	var body CreateDirectoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDirectory(ctx, request.(CreateDirectoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDirectory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateDirectoryResponseObject); ok {
+		if err := validResponse.VisitCreateDirectoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RenameFile operation middleware
+func (sh *strictHandler) RenameFile(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request RenameFileRequestObject
+
+	request.Id = id
+
+	var body RenameFileJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RenameFile(ctx, request.(RenameFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RenameFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RenameFileResponseObject); ok {
+		if err := validResponse.VisitRenameFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// KillServer operation middleware
+func (sh *strictHandler) KillServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request KillServerRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.KillServer(ctx, request.(KillServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "KillServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(KillServerResponseObject); ok {
+		if err := validResponse.VisitKillServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServerLogs operation middleware
+func (sh *strictHandler) GetServerLogs(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerLogsParams) {
+	var request GetServerLogsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServerLogs(ctx, request.(GetServerLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServerLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServerLogsResponseObject); ok {
+		if err := validResponse.VisitGetServerLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestartServer operation middleware
+func (sh *strictHandler) RestartServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request RestartServerRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestartServer(ctx, request.(RestartServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestartServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestartServerResponseObject); ok {
+		if err := validResponse.VisitRestartServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StartServer operation middleware
+func (sh *strictHandler) StartServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request StartServerRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartServer(ctx, request.(StartServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartServerResponseObject); ok {
+		if err := validResponse.VisitStartServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServerStats operation middleware
+func (sh *strictHandler) GetServerStats(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerStatsParams) {
+	var request GetServerStatsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServerStats(ctx, request.(GetServerStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServerStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServerStatsResponseObject); ok {
+		if err := validResponse.VisitGetServerStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServerStatsHistory operation middleware
+func (sh *strictHandler) GetServerStatsHistory(w http.ResponseWriter, r *http.Request, id ServerID, params GetServerStatsHistoryParams) {
+	var request GetServerStatsHistoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServerStatsHistory(ctx, request.(GetServerStatsHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServerStatsHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServerStatsHistoryResponseObject); ok {
+		if err := validResponse.VisitGetServerStatsHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StopServer operation middleware
+func (sh *strictHandler) StopServer(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request StopServerRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StopServer(ctx, request.(StopServerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StopServer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StopServerResponseObject); ok {
+		if err := validResponse.VisitStopServerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+// CheckServerImage operation middleware
+func (sh *strictHandler) CheckServerImage(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request CheckServerImageRequestObject
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	request.Id = id
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckServerImage(ctx, request.(CheckServerImageRequestObject))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckServerImage")
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/servers", wrapper.ListServers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/servers", wrapper.CreateServer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/servers/{id}", wrapper.GetServer)
-	})
+	response, err := handler(r.Context(), w, r, request)
 
-	return r
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckServerImageResponseObject); ok {
+		if err := validResponse.VisitCheckServerImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListServersRequestObject struct {
-}
+// UpdateServerImage operation middleware
+func (sh *strictHandler) UpdateServerImage(w http.ResponseWriter, r *http.Request, id ServerID) {
+	var request UpdateServerImageRequestObject
 
-type ListServersResponseObject interface {
-	VisitListServersResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type ListServers200JSONResponse ServersResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateServerImage(ctx, request.(UpdateServerImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateServerImage")
+	}
 
-func (response ListServers200JSONResponse) VisitListServersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateServerImageResponseObject); ok {
+		if err := validResponse.VisitUpdateServerImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListServers500Response struct {
-}
+// ListAPITokens operation middleware
+func (sh *strictHandler) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	var request ListAPITokensRequestObject
 
-func (response ListServers500Response) VisitListServersResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAPITokens(ctx, request.(ListAPITokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAPITokens")
+	}
 
-type CreateServerRequestObject struct {
-	Body *CreateServerJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateServerResponseObject interface {
-	VisitCreateServerResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAPITokensResponseObject); ok {
+		if err := validResponse.VisitListAPITokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateServer201JSONResponse ServerResponse
+// CreateAPIToken operation middleware
+func (sh *strictHandler) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPITokenRequestObject
 
-func (response CreateServer201JSONResponse) VisitCreateServerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var body CreateAPITokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIToken(ctx, request.(CreateAPITokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIToken")
+	}
 
-type CreateServer400Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response CreateServer400Response) VisitCreateServerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPITokenResponseObject); ok {
+		if err := validResponse.VisitCreateAPITokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateServer500Response struct {
-}
+// RevokeAPIToken operation middleware
+func (sh *strictHandler) RevokeAPIToken(w http.ResponseWriter, r *http.Request, id TokenID) {
+	var request RevokeAPITokenRequestObject
 
-func (response CreateServer500Response) VisitCreateServerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	request.Id = id
 
-type GetServerRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAPIToken(ctx, request.(RevokeAPITokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAPIToken")
+	}
 
-type GetServerResponseObject interface {
-	VisitGetServerResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeAPITokenResponseObject); ok {
+		if err := validResponse.VisitRevokeAPITokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServer200JSONResponse ServerResponse
+// ListUsers operation middleware
+func (sh *strictHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	var request ListUsersRequestObject
 
-func (response GetServer200JSONResponse) VisitGetServerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUsers(ctx, request.(ListUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUsers")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetServer404Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUsersResponseObject); ok {
+		if err := validResponse.VisitListUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetServer404Response) VisitGetServerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// CreateUser operation middleware
+func (sh *strictHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var request CreateUserRequestObject
 
-type GetServer500Response struct {
-}
+	var body CreateUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetServer500Response) VisitGetServerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUser(ctx, request.(CreateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUser")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List all servers
-	// (GET /api/servers)
-	ListServers(ctx context.Context, request ListServersRequestObject) (ListServersResponseObject, error)
-	// Create a new server
-	// (POST /api/servers)
-	CreateServer(ctx context.Context, request CreateServerRequestObject) (CreateServerResponseObject, error)
-	// Get a server by ID
-	// (GET /api/servers/{id})
-	GetServer(ctx context.Context, request GetServerRequestObject) (GetServerResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
+		if err := validResponse.VisitCreateUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentUserRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentUser")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// DeleteUser operation middleware
+func (sh *strictHandler) DeleteUser(w http.ResponseWriter, r *http.Request, id UserID) {
+	var request DeleteUserRequestObject
 
-// ListServers operation middleware
-func (sh *strictHandler) ListServers(w http.ResponseWriter, r *http.Request) {
-	var request ListServersRequestObject
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListServers(ctx, request.(ListServersRequestObject))
+		return sh.ssi.DeleteUser(ctx, request.(DeleteUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListServers")
+		handler = middleware(handler, "DeleteUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListServersResponseObject); ok {
-		if err := validResponse.VisitListServersResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
+		if err := validResponse.VisitDeleteUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -512,30 +5829,26 @@ func (sh *strictHandler) ListServers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateServer operation middleware
-func (sh *strictHandler) CreateServer(w http.ResponseWriter, r *http.Request) {
-	var request CreateServerRequestObject
+// DeleteServerGrant operation middleware
+func (sh *strictHandler) DeleteServerGrant(w http.ResponseWriter, r *http.Request, id UserID, serverId GrantServerID) {
+	var request DeleteServerGrantRequestObject
 
-	var body CreateServerJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.ServerId = serverId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateServer(ctx, request.(CreateServerRequestObject))
+		return sh.ssi.DeleteServerGrant(ctx, request.(DeleteServerGrantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateServer")
+		handler = middleware(handler, "DeleteServerGrant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateServerResponseObject); ok {
-		if err := validResponse.VisitCreateServerResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteServerGrantResponseObject); ok {
+		if err := validResponse.VisitDeleteServerGrantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -543,25 +5856,33 @@ func (sh *strictHandler) CreateServer(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetServer operation middleware
-func (sh *strictHandler) GetServer(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetServerRequestObject
+// SetServerGrant operation middleware
+func (sh *strictHandler) SetServerGrant(w http.ResponseWriter, r *http.Request, id UserID, serverId GrantServerID) {
+	var request SetServerGrantRequestObject
 
 	request.Id = id
+	request.ServerId = serverId
+
+	var body SetServerGrantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetServer(ctx, request.(GetServerRequestObject))
+		return sh.ssi.SetServerGrant(ctx, request.(SetServerGrantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetServer")
+		handler = middleware(handler, "SetServerGrant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetServerResponseObject); ok {
-		if err := validResponse.VisitGetServerResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetServerGrantResponseObject); ok {
+		if err := validResponse.VisitSetServerGrantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -572,22 +5893,149 @@ func (sh *strictHandler) GetServer(w http.ResponseWriter, r *http.Request, id op
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7RWW2vjRhT+K8NpoS/CchsXgmAfdpNlMSzJkg19CaZMRsf2bKWZ2bnYcYP/ezkzknyR",
-	"HCeQvpi5nDmX7zvfkZ9B6Npohco7KJ7BiSXWPC4/cYd36HSwAmlvrDZovcR4K0v6LdEJK42XWkEB90tk",
-	"QcmfAZksUXk5l2jZXFvml8hs6yuDubY191BACLKEDPzGIBTgvJVqAdttBhZ/BmmxhOKBQs06G/34A4WH",
-	"bQY3uP6OdoW2n5zQai4XtPrV4hwK+CXflZk3Nebp9VWyPQ7auBgKvIvKq+p2DsXDy3F2iW6zly0PICfj",
-	"w7Kc5z7EFapQR2iU9JJX8l/CLQNZVgSv89z6dGKDUmnlvDYmLdFaTWXOziHfBOyDcBqWqw56rfAV4Oy/",
-	"utbiH0JpduStOe/RjGolrVY1Kj/cjHsGbMWt5I8VOuY1c+iZVrEtXaImA3zitamQ6v52e3f/4XJ8OYYM",
-	"bm6vP//9+eavD8bqMojofZaB9FjHJI4Q7IDh1vIN7WXNFzicXyqMRQtKKzjs5NLl1QtgtE1i7TuMV+QJ",
-	"n4x2+FKNl+OCKsy9MJDBZHJRXE4mF3H7pvLSfteSZSJrNpD4SlehxhOpN5eUfK2DepGffMVtvl6v86Wv",
-	"q+JgBxnk6EWuFlI9pd8RabkYPH1LqUfaiJctu7vaWnqyg+48rZc7dEYrNzBhXTdmzuunr9x0fDquOxc4",
-	"TfkWm9ekcAaw1m0/J7KUaq6HGkM6Jl1shI/fpqzUIhCgnO47qaQEjA5iSVYjNvW/ORJTSd20QIWWe+yc",
-	"iErSQIj3j5ueh6uv0xHJTnpqNjhyTlSjdSm98Wg8GlPh2qDiRkIBF/EoA8P9MiKXcyPzPUgXGIcVAR6r",
-	"mJZQwFfpfMMLDe2Gmmj/x3jcfNJ8M+e4MZUU8XH+w1Ei7Xf7dUztqI/I96XYZMvWaGkeBVVSjX+mRA7N",
-	"PyomlUereNU8Y/HrwrQQwRLzFMKFuuZ20xTKeNUauzTP3AAkVxa5x++t+qmT0PlPuty8Gxx7H+bDZvU2",
-	"4LbHw+/vzMPraGBr7piIYJTMBSHQuXmoqjh8J0Oc3Mc/WxGu+FiqFa/k+3GYmGGcKVy345ks9js9f5bl",
-	"9mS7f0HfEWu45TX6qI6HZ5CUEYkHMlC8JgXGv4iH5GR7QJ/7Mzn73wX1BiI7NU3Gk2Hm9oyV9u8svy/o",
-	"GW8tHzdsek05b/8LAAD//26GyeMGDAAA",
+	"H4sIAAAAAAAC/+y9e3PbtrYo/lXw0+/M5Jy9ZVl+5OWZzp3USbpzd9pk7OR27rQ5JxAJSdimABYALaud",
+	"fPc7ay2ABCVQku3YsbP9TxuLJLAArPcLf/UyPSu1EsrZ3tFfvanguTD4z1cf+AT+nwubGVk6qVXvqPcm",
+	"F8rJsRSWualgVphzYR5ZZsS5tFKrPhtrwyor2Fy6KXsz3vmZu2za6/dsNhUzDiO6RSl6Rz3rjFST3pcv",
+	"X/q9khs+E85P/ZPhyp3i0G9ewg8Spi65g3EUn+HX9Djv9XtG/FFJI/LekTOViGcaazPjrnfUqyoJby7P",
+	"3O9tmEVed/wP+kyomxv+o7054L/Ax7bUygo8ldfajGSeCwV/ZFo5oRz8k5dlITMOCLJbGj0qxOzv/7Ia",
+	"X2tm+w8jxr2j3v+/2yDcLj21u6+M0YZmbGPbh6lgGS8KYVjBszPCuVKYmbSAbIhrbiot4xl+ATuieOWm",
+	"2sg/Yc23CSjstLDOQ8rZOS9kzl68f8McIAGelx8I5nnx/g0iB0JWFO/GvaPf1s/+I7fiRFhdmUz0vvT/",
+	"6pVGl8I4SeeTGcGdyF+4VaoF8HLuBOMqZ07OBG4kgsXm3DL/aa/fIAW8vgOvrmJGv1dw6z7aq80F3wKD",
+	"2H42QufleV4w+J05zYzI9ETJP+OZRovUSEac67Orge0/3RroyiJ3Ss4Dz6LhAWGBq2bcCcu4jefopPyG",
+	"rn+jDaqn7EeY8Kn+VI/+JTLX+7L6S79GRXvi6R3AbiMXQor/kk7M7CZCqZH7Sz0dN4YvVkD346agaqH7",
+	"0bWx3fihroTwsusklfyjEkwGqWg8S2qm23yY/V5V5tdYCxEUDbHlgpYOQebJAzjWyupC/ENap82iGzcK",
+	"qcT2qOFHfSuVWMWOfs+ZSmW4lJW9+HUq3BREAczH+NgJwz5bqTLxmc2FESw3uixF3mfcMq2KBe7VTFsH",
+	"LEIoF740gp2J0jX7MtK6EFytbAytLAZqzT7hilY2x4o/0qcKYz+yzILUUJlgqpqNhIkPUCr35LABUion",
+	"JsLgJokLl9Ck+j04buv4rNwWkwAKxKLSwPhXRCBYZDy5hzC1WS91diZMoGu7CudbOZMOzo/Np9xFWibL",
+	"uELNUo/x16m2bsDezaRzImcFfQZnWyn8Q+SDXn+Za5TV6ZSb1LwfYnV2LuRk6hifcKmsYxrwzrL5VCic",
+	"+vj9RyYtI90iB4ybTwEB94b7h/AA3snFmFcFIJm44LOyEL2jx3v7Wx1vVlYdAMLEf1TaccRx7pEGduT4",
+	"/Ucbz7U3eBwfpa5GRXSOHtn8XMJ1zhar+WzGF8xUimkVz9Qb7hykuNpMzLRZpEeecpMzeoFOjkmQ2U7Y",
+	"PuOOFQJ42pOf5Y/xRPt7h08Pnx08OXy21S7S8CcCgOc0d/LM9dh1gSLUWJtM5M3JA9LBCRd6Dijq1xjv",
+	"+/DpwdPDvWf7h5cA8nTOyy4uARDVM7GyqCyzc15GUGrDdvbI7gqYj68M2AnRKGBKvMJBDPDh/vPD50+e",
+	"7j9/shXApcwtkmgaXuS2pdGZsFYkkYfDclAuXpIuviS4CSnkq7qhYievj9nTZ8OnzGv6LBeOy8Iy+rjP",
+	"6IuRVBM2ny4Yr/X3MZcFMsI266Dvk3OJi7LgCnGM2VJkciwzUEzRNNFZVhmDLN4zLg9RimYErKeD9qXK",
+	"5bnMK16QaUHz0Rd9JsdeM6BFAEuXCt/r9bcTzK+lKHJv4azKZeCDXGUiDRsYnmF5NQhTmU2b3VxZq3Xc",
+	"dfG5f3z48J7RCyzTeQtbDoeHSZkoXZE0E+xUG8dsNZtxswhAnkmVw7+bs2j42S/asde6Ukmo6YfVWT6e",
+	"vAlq4AJwatMklVFHRBmlrrLpkX/nSGm3M05Pvqw6w9Ow7no7UzL31YXIToQFabSin4gL6Y5hhxt1ItpU",
+	"63JhTFLVsC7XVYcWslmHExL/Z50RfBaUDzbTBtQS7q1SXrrKxMjTpafVi6jBqkHfpLzB3pwiFEF/Wz5Z",
+	"VJHgODM9m4Hy5KFFxgusjEn3yDKAAX4ELQT+HfD2ctsNgGwi1Ld6QhCvUQVTzDIi8BU0GMOzNC3q8Vio",
+	"HHC69tI9smh84w5w9r9P3/3CSg3rMEwqZHwNGxjpvCUge7uZVmM52S21cXZ3mFYdrOUTkUIfUuoRXJDD",
+	"DY9bTyy0vmbkT8n9KcQbNdaruzPTOVh33kLbzmREX1xyQ8eyAM0feeZcuqlUHr2U41KhDbBKbfJPsXY0",
+	"eKHWCba0IjwrE6qa0R4hI8mlEZkjtcYuZoVUZ9F2eZD6vYsd+G7nnBtABQsDwAZ+WJTiNQ0U/nwZDRh+",
+	"Ow0DLx+Ud2F65obL7sf733VwJyK4iZYQ22gkqJlUb4WawJnspdi63vjSMkbBwPhhN0hdFjPu9EZx7LFx",
+	"FZWLbvy16yfd3kxvpt/gwaFhU/C8mfGJ+IiOiVVo0Bm7TkJEqiP537zPhsHGk+3KHTAYCdMkZES/x8+5",
+	"LPioEOtnicdhuRyPwdarZ6nJ8pFNTkLanXspJ8J2OXDwWVA9ogFpzj5Df7aagBInUXdTjxwrq6IIywXt",
+	"dCKta9saPTvl+4+fHB3y/dEwey6ejffyg9Fj/jR7Ip6Ph/n+6JA/yZ6JYf37c7E/PsyfjJ7xYbYnDsaP",
+	"86ej5zzp8Zp5BtzMJt2fk92ZVCIzfOx26HCOaPfSXmJ4ssXGwK7gfI8sc3yC7q3iHMwHnVru8/Ez8TR/",
+	"kj0eHfKD8b7Yy4fZ89Ez/nT8RDzOD7OD0T7fGw/F8/xZ9nT0hD8eH4qDfD/bGw05fLvZH+YxqrWAGJ36",
+	"NfpuwPtuaqxqulhHhTEJLUPpR0hB8FZPOrxRX03B+Aq+JjJawiiWfHhebbiyJ4rgXuOAahYXib5lrXE7",
+	"eVcPdRo+j3/Bcb70e7+IeRzraR/IlWMbG+TZNcIP/eC6ssyrchSCu1pYInUE0Y50E4gFht/BNxBwdJ7C",
+	"O32AcwTSQjlyi40EN7BG3POktPeHsV0MIxWzAFwhCDtW2Gg9K0vrVg1r3WuDfngZRQVn6wASrJ9EbIUM",
+	"nTSIwQoa66LQc5Gz0YKR53VSzTCPIGLZv/UsaHM7GRhCk//Jq1nJeFmy36vh8ECw3Zw7vsvLcmD/KADG",
+	"WjVZNQmkekMP91adE0KdS6PVzAd6l4gpz0UeULn28EafoC/1n6/+7w/nvKhE7C4puXPCwCD//fvv87//",
+	"MPjbf3T7BBpwgMBSRI2ER+kR5mhidFUiiVcqpjp0HuiWTMTxEvPOtTmTavJSJmZ7uUTDYSz/DYu1/PX4",
+	"E7ChA4UogyKFRGDlbSIy+vqY3l0Oqy+v6RcNHCoRGZCWjTXSBb8IdHE4fP4kqZSMREEaaJ5LGJgX79uh",
+	"zuVP2kC8NkLsACNkNBRssDYTjkwaCUMWYAgTaJa4dU0QIHLOe0ewV3lFSQv93oTyNWrVqpey3rskxbSa",
+	"ccWM4DmoJiQ4QhCSYLgkv/DRZH9+a08dE3VWj97ozeYNDXACby4DgJ93zPvRpnBN2hf5THqMobDL0ZgX",
+	"VvTXqP1IjTO+YLlmXC2Az076TKqsqNDbMeOKT+Af8GJa90+fSZCxQLkUH/ZbeulTSG3CieC5VMLa90aP",
+	"RIrwM5kLH6zizDpukKc0pAK4shiwJuAFLy1s82qlnCxqV7VgJbcWIzMKfS7ochY5s1NdFTlYLEo7fImB",
+	"qJIzkQi8bSNSxIXIaEqLXDESfgRoH2cBEL3fDT1tlg3bMsdkWu1khQQVXlq3XrCsOrqdMOc84ek/FZlW",
+	"uWUj4eYCNtc5MSud7XtXt+DZFBHK8TMBMqXQajJgMSd+PCA0kDPQO/eSAZZO5QAVgqlzZb1HpCvHuwKY",
+	"zPYvLkDMHFxcsJDB1fbATQUv3PTPDrcViby/kilOk6rghomL0ghKwcoo+B1AmnEXdgLVfT1munJlVcdS",
+	"W4C81Eqw33//z8Hffv/9v/6/JDjadOiAZTUqpJ2KnGJy8CJzWYlIGm9SppUSmVuyJfcfP37yOB1GmAnv",
+	"106ffkMWU45nCpvfPuUnw+Hmc04HEmoGpSPAGcfl9WufKmcl+g3muNteOYRjQI8sKhSBqGDR3shxWdnr",
+	"92BviL3DB7gnItvS4Gnzng+LUnzAMVd//wfNsvrguJ539Rlqo5+ScY40J0SO9V4XMlts5UhC5ocfidwn",
+	"kAAD8aSDaQdzNWB+YMtGPDtjejwGhEf5JXlRLPrkk3kM5geiBOlxj9lMqsoFRtmeNjPcTgutS4o3ykKQ",
+	"p0c6u8osZ/ziRDgjU2kK/9BzkEyLsBDkuZwZ/HmB4TY2EmMKpMQQFGLsAutGNBkC0ErA44k8F6wqo5Xj",
+	"/5iGh8hq3bKwqErUMvaGzao1zhO2d7RgU67yFiEMkyFwHw7pJAS/UoK178+NM6XVzp/C6CbaQvEIeqwW",
+	"+HuE/vh5r9/Tagd2qTLoxynmfGG3Rv8I4X7WufjFD7ny4J16XU+x8vCFn3MZ0XEjUojeqNjbJYo2WvmX",
+	"/jVTSrmdCrulEu9fboV2N392Su/WX50IblPKfwj9NBhdIzMfoemPWTpzabfxEuGccaZknIXXrxe+Xf5k",
+	"y4g5+qunldjilOKvKCuq9+XT0mj+99Wo7TqDF6Rj9AI750aCbYCyyQpMJ2lZB5Hq9P7dyYcfng2fgUb1",
+	"y7uXr/7n1S//54fIWLmcKjVLBvEAPlqY97k7jXldK1ZLUhvoyBjARzASsGor1q3x2fAIVrhL8vDw8ODo",
+	"2eHhAf55qeWZFU183XEv6e2UXd8kwa37dDlnjr5ty771U8cvJyJ/OaHZp8SWn+uimnXly/mHsO0zXam1",
+	"mLV7zs3ufD7fnbpZcdT6q9fv7QqX7aqJVBf03wGYn0fJXy9zSOmUieDfD2sLiNV2JK2h9IYtrgrniEeB",
+	"gjgCS2FVyWCYt0qJglzMtApyM2E5VSkir/UAdKAvz0rJCFSDk5KUyXhwnJuQ4Cjh23guzD32k0WLg3lr",
+	"tkxBrSkGtZLzwiC4pakE6F9D5l08I7HnvGuKK8QNaJe7j7z2dFxSAtN3q5K1Ll/aqsynnWvrv7yMbNoq",
+	"JGVrNWOz2GrS1q8Twqr5xJpYlncUfSWo0wCsmVenosfnUsy988gK0XJQgBJnuNP0tPExFguUcUAhwWQG",
+	"K8Hr2aBV65JJ1ye3k0h8nk25mgh4NxeFcDDWgL1TBbw2k8riF/4l3ji6fYg5sGptSFSirlxWZkIw5dzx",
+	"QaQs0wop9YLWg95VBC0pJxplzq6e0ajQ2RkIwNWt/HHhBLmkyKrKpT2LmGNjc5ilmGB3XgvO9quRTnRN",
+	"NzfSOeAr+mvMl5XVe2GypC52/P4jqywoOUYU3IGdhfa8lWpSgNluRJ9ZXR8YG1V2gWJ0rvEp7k02FZbt",
+	"D4e9rVK6KdW3TtLdOgv5Yzrn6mfKHK6syKOkY6VBGlTKhWTHEhaZAajb7ZoSbq7N2clFN05kQp6L/Jqn",
+	"4+f50DkPSpDrzVHKvENDahL0m6Ro8g4Aym+bqCXJzXwFueZfilC0fdptbIlPJd65fkTBLfryS+/mn8gQ",
+	"1jDvwC+2sxNt0pLbNH9lY11XKukkL+SfZObLPGTPou+71++ZSin6F/Dkkv7pbc1gGnpPzgZOWNlX5yIV",
+	"IOkqJ3vzMiQJhcynKdgyIREaeXu+TUWZEvPTK9ngusiv9uGls0G6F3WpwrUY5HjdMUTd6LEGNZW4cMeV",
+	"san6Agxa4LMQXoW3vVM2eCOUTy6z9CCZ1UlAbJ2U1+hea82cMGxy3UAwG0v6MAOr6i6aadha8IGiy9+i",
+	"tccyfd6KmMUZzvjGZVfsaX/DsiOgm4lSexCCiN+m7BrjjlcpQp2ALdEhZ4wuvBWIw091kWOkPCoaCVjR",
+	"v8zOB/tl1bcTh1zvToh1q9B2gL3t//Pbu51ZBSi0JrnPbrZIEAlXcvpshzUCb9v1E25PVDT1BmqiIVdh",
+	"QbaVVUa6xSmM5zV8zLh6UaVily9U0wUBc21CJq+bGl1NpuwY/w75VmiY+IIQrdhYGutIHavK0EcEkQSn",
+	"bM4cA1vYm0H6JP5lnJE2FGcCPLnOMGGJ6qiC8/G0qcmBtwbsjaMMHEwhmggF1pCoB8kKCcwen48WKyMc",
+	"v30zqGt0jnpLg/f6PaBIAm84GA6GKIJLoXgpe0e9A/yJQsK4y7u8lLviPHRrmaTy4154AHZOATBUQKyv",
+	"sxnAn4ZcRrGJ2BLFlnH2mX75zHAujOrA/0kbAVMRfvKFH0JlOhc5W1F7Bv6n5rwpNOPjmkD23AjPDeBp",
+	"5fSMO5mBtQu7BgiOh/Mmr/eO1tNbak0CplG714cTF452aqfJed2uyceq/tbR8IM2x5cwAUOHkxM5nOHh",
+	"cK9rmhrw3Varki/93uOVVdxgx5IXIBucMKqWDBRPCfWKOVE6Vc3B9tMyl1ElM9paxotGvMBniKeRcpNE",
+	"1LfSuthxaaNcU6z1tgK0KTIsuWOcUkvYe26trzBylVEiZ42qxnih1YT8njgyZkNhQhbZXRbTBICQXVtv",
+	"W0U4gO+0lplxh6LflpeCXhiPyPVqyPmqQslhrcejwxnb9fxRCcy/Cy2NgubaHO0ldaQqqSRtBa0et2GF",
+	"QboADcU5q2BuEVC4EnSVJTeDtHW5Rwqw8Ky719R202XcGKziFMgvtRLNxlDWXx9j5gqk2eczsaDU0c/o",
+	"VPtXZR3++BnjIpiiAVtbZ5cmAMdB01sa5aD+9t8/fPr7f/4w+Nt//a8t8lBhrUk008a1pqpT5lrqUDjK",
+	"+LelpLR1sXKPlNq442iA5tdfcKhOILXJUbanoOQ2i+Cjv+BQt4VMG/cOhn+BX9Z/vsQhEijywfMJz2Lq",
+	"Km5xLnVlg3GXWgXZiJdDSKyi5xdyVs1aZpZnkdqzvS5E8n6cxL49HmJ+LGViPB4O1ycogda7QcbG0uly",
+	"UmnZ+O4QsDUnFUYwKopG2Tr8No29opp6anPno5ssr7xXd8YLMOVE7r0D36cqAIKxLfL7vVJTjVdbhBLp",
+	"n4Yoj9/IH3W++GqoFOW6tC0YZyrxZQWH974yDm+HwrG5z2yVZcLacVUUQLiJloupaf1ru/gOTnYX6ODK",
+	"CH44PNj8UdNx8K6TBCE640yJech+WFaEd/+S+RfiyIVIxaZOxEyfLzfWrKt+UH2lpPyKSAxsU1ubY2ei",
+	"dKxShbCW4nov/QMrXJ9J5ZOUM+6jkCEgCMOOpMopg0PkdWWI9K2yDIKVg6ozF0Wxqii/xPXUVL6kKaf2",
+	"v3llt27AuSoJWymAAEN7Z7oXwB1fjYxSrDPEOVOys961tPz0BQUrrShWBeXhuu5SSD6EArdJPocE1e1x",
+	"i2i1SrtGfN9pOiZUrn0j6HUVCcH2k3Bd+P71G7HevCZ2CSkWetNcQ2xdCd8fsHcz9v4kXJRIsGDIVXtl",
+	"qprhvTC29r+A0GpJFuSpoeNCp0DCfA3pBuwF8zHTKDkXY6ci97noUxiwzncBYTLhUpFDcM5NbqkcidKA",
+	"2Aws6BGYQbmoe/C1gPAtG5qu06NFXQQDLwK6+VYKlFtCzhhbN6RelWKUovT1pdiHAE7dHotgpgAJLRjA",
+	"jBeLfoS/YV6sfwFkN6bq159Ly3zrCdg79KPrkpKF2FQY0fffkiNKVw72g+T5v0QWZQce7j+rJSKRa8O5",
+	"turf/elOaPbfkCf6zPEHzf6eqyaHe/vfBJA6Tzj0OYrSkRp6V003fYB1/9ntwRomrresNPpc5vfAV0Fc",
+	"PQ52tQRd2kjbDcVxXaGLj+XE8NxLxhDP0oqcQL+K0anOzoQbsFd1HWSU04OxTVsH1bBhhRMXjvneZFQ+",
+	"RsWk9EuTCidbuYstkWgdVzk3OZOqrNyA/UzfWiriOtj/p/yRZYW2YgnorkhbU6h3VVG4rLfuETtZKquc",
+	"S5dNo6YH9fYBkjmd6eLBSrpjRPWuFKAi0kc8w6zWkNTsdGQ9rSOu3SklIXUS2Qn6uW1HE+9lgsKixiLH",
+	"Bq7SgAqFWbU+Pp9xxTLkYFUZyiN1KVTQFz1MA/a27hBOvnfKeYVXMCIB/1qQP7qe1ZfiwFCoEe8tByGp",
+	"wGOVzn4Srt1i/Wuqna24VtwxHYNoq13Hk5EiSY16E86QYSJxdV2Z540asR2N6rsuFPGY6vEvsmof+Mzd",
+	"tWcf2UD/4fyor0AHkxGhsZFO9Z47qZRtSuRX+0y0/a5AzVbm5Dadgd3qc7n7WMCNhK8NmFeOSqzIMev7",
+	"Hsimka0vFN9jP8sfWVm3vw0lzeSg9fc74If+QoO6ntxgE1824+aMHLJ1b9sBOy60jdhZUEkEN8WCFYKf",
+	"1+oKLdqb7Kts6dWFyI7r5gHXEv43YhRil4BbNgmjDsqdXCX0MJG1Y/fBivsmVtzw+TcBRGLXzlA9cNd5",
+	"60ncJCRwwA2aGzDV3SadbiveWsgzwSKW0mejyoHlE3LGQ5sY2bBMbpnVGpNrsLV2rWrxlazDdvvuuAYV",
+	"xh2wd8GOjunT9hlnYwlbhbNn3GC0C++MafXvThhHONm/B4+82FH55flk1E19A6+UNiaXB375wC/vKb/s",
+	"e00OezjXfKyDi9Z9uJs8gFQ83TdR/6pxiC06zycD4xTN7I5gbmhst9qiDqOsrUSDxmTGnEssFfGm96wD",
+	"LCOyylh5Lm4oXg+7tRqtv2UmhWclOxLPgoAEeg4oWSfxrmRK/BswN22aU/u2TK7ur+r5nJiVbtEPgVXC",
+	"29DpHVOJ709uBO1wmwO2sSzpz3vP3ZRca46qWoVQ5DFba3/TnoWQsH9VNzqjn3bAfvVhTo400yfXW8Ri",
+	"UNukt4GgQrYQp6hoIa1DZ591gufpagC8YOFr8+RtWz6vYczbMuKb9MG1L5/YSBR4cSVu+QNXvb9c9R6k",
+	"Kcc4182yuhW13WhxyVSwl3quCs3z70dfuxyb0JkT6SK7OkAwkoonG40nkc4v2k9n24kUx/TrzktpS21l",
+	"qFBfc7n8A3t5YC83og55ut9KIUrm4B1H6XUwBDXvFWXBMwE44tua8QIbdjNxIa2zPum7ZmqoGLVe8X26",
+	"yQ1GyXhUqxZS/RBcq5ev1rVOFkVoIEWXhC5nyH2HfG4b99s1WdzthSlat46tYa8+aw2O80EBe+CQN5Z7",
+	"tSV/XKN9RQZcHHJIlZnF1wzePY98A90t16ltwxPadlnoS/PAFx7cXVvxBQKiracwf0MPyuh7U753XWPR",
+	"NLeQJjkV3VJ6TRXqhphUdIvqXVZbaIsfuNMDd/oK3AnMoXvBoYgwYUuxELatU3lv+Na86kwWRTeP+qcs",
+	"imuXQd2pmkVYr3iI7d8iIBlXjzBeQ1sfSgXrkjXfCunOk91rbTKB9Vy4kk0JUoWe2DX57Dy3y1cXtjJN",
+	"m3tx6w6JoWti3RGP7g6yUSM5H0QM+ZxR0UmrxKSVNuUvCF7Nl/pVuin7nHvf1uc+o5txfe1mLozxTdsw",
+	"TEabicmlOd38z9nkT4k5q1jSAlwqmfhONPwW9utmk97DvjXJ71HrJq7YyetjdnBw8HypUac2tGt5qIj1",
+	"RQNKz5mFXeeWfd4bzj5vnTp/uU5UbeDrC51uCXq8APAa0GONwSzSflbqN+iqpqLwL8yYHDM9k476oSab",
+	"jvElkNaWG6zA96YGTdAeNcmH0ZXUXXPXl1RfLtVkBYp/ClFi8iOQtK/KQAfw4lFcUtJcwFh3I9dlVzc2",
+	"uoj30oClO9CFa7BXhyLf6iVGEsZcdqTlG/d82juwVULowFy4czyb0uW9oa+JlziZno2kCuXN9eakgAxs",
+	"7rr5Q+sEGEB8WZ9x/5pZmOH69w4ZTdtpBBsJqtmnDr/3oq8W8dUHTe5Wa5OVjpQU7MbDc8Ii1OsWwt0D",
+	"+wk90U0DCdA80kqcr+db58TBF74vG6m+F/OBuL6BmdTcSnpfLSVPFJsspA2kdfr9EdYDWX07srrvRHW6",
+	"JUmtaQd/SvdgLLkc/DaEGyjpiqqgSjt+hjXwcIw4eaZVHlwDpFB/7sMD0tLQH07uAPQ2UM9k+irheohu",
+	"9Fh2P3QZPms8CHQzyLVcCF/Lprp5rtO+TOn6dkL7YqU1FIU3IPDJg+L9UB61pX7d5izrONfmPiCt3nJk",
+	"uRLryT2voRvI+6HjBhYXeLaHXVHBpuQs54sBe+HdgOSERa8LLQIwlU11ZQpfpVF/eTCET32HOpqqHp76",
+	"qflrCOSY2oPg9UeRc4sZrkJbH/iTZmFaCdvcWT1geCEbXqxxLgyfiDzcroCeKvio772/FLnCy9mo+2mh",
+	"szP25h1z2vEi1FgIVnAzAQPbCqE28dCbbkFS79hN+GGfPJt+HrCXxKEt+QxyvmB8ogc35KAN67kZ1+zy",
+	"cpSeD67sq71RwZS6TmyTLDEi0wZ0Aa92xH17HjxRDy1jvqoc8qi2pUDS5TrjVJffm22K/WUe1LpvYptS",
+	"b5/7apv+ZHgIjePd1RusVOqRukM39HTeXWbPwtVOE2mdWXiLFL9qm7COT8KOFQuk7gI7HOl+3e+2rIqC",
+	"KvJXlZ/jqcjOorvR7yhFp+5t3yRdabMwkRcW+UDetwlIrgXabVOOWVqtsAkMCaq5b/J897NiAX288aTw",
+	"wnui3ui6l6UK76oo7AqZ+nokrGUCLTeiVcy4HY8F8j3C21TX8cAjl+5180lu/ej2B7oNAi+4H7AXzS3K",
+	"0veuDX3KpW13Il/fDvwuc4gIwivxCWl9Vzr49oFT3A1OQcLyHjZX9gwiaAB45euaq0rpdhYrhHfk+Cti",
+	"0SlCdwJTTy54w02FNEzPVbo3Q7g9dou7Qa9ObPUkm4iMFr5ycdl3exNYfb+v7ZYO9b48giPPjMAWlzpy",
+	"qvheuxLdh7gffeD6UZTUGSnOQzafvzhi+aIfkh/hCEB8RfiU0ARblw/f3C1l9Qy3XP8VTb0V1nbfVfZw",
+	"59jdvXOsJsBl5pu4dWw5m+Vcn8X4fzktB79KKzmHa3iAzzmBqb9r+6RZbK10MI01+aEgJdqEO+5ZAzgZ",
+	"Vylcq++fT8p54tDnXBZ85FvEI7tOS3K86v4mpXj7Lv2OgyPt4ysI8O+M69Qin468U9pvf+bEx+BQbk74",
+	"4ui3LHhhzm2w7EHgxvz5VisDafvJqSAtwxK7dqHg/dICKhv7X5FCd2eis3XVT8Idkwu1pr4bZLlb08J3",
+	"bDD9JKj4C9fqInIDhaByU6GcxH7+jNvlg9x0fez2HJc6Ofozv5y6Bx9dSturT/Xf4MrTeq338MJThL19",
+	"cY00wYjmKmcTw5VLIuUuPdr9iyZ883XRlBycP8EUV8bW/sY3cfzb8dhuwwuNrrsdfPcm0j2kmmALIeyP",
+	"LB2XVq17g5Mt305CdzcXDrmWBqUR51JXtliwqShypuOGuH0mx4yrRfB2bUM+pyG/61vQzg1eB0rLueUW",
+	"JZciWuSHD5fA3Brz0Oae3m8FeFLL3gQXwddFVhnpFkizI8GNMC8qN+0d/fbpy6cv/y8AAP//j/Tg58HT",
+	"AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,26 +1,65 @@
 package openapi
 
 import (
+	"net/http"
+
+	"github.com/getkin/kin-openapi/routers/gorillamux"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
-
 	"github.com/pkg/errors"
-
-	oapiMiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/rs/zerolog"
 )
 
-func New(ssi StrictServerInterface) (*chi.Mux, error) {
+// ErrorMapper converts an error returned by a handler into a problem.
+type ErrorMapper func(error) Error
+
+func New(ssi StrictServerInterface, authenticate AuthenticateFunc, mapError ErrorMapper) (*chi.Mux, error) {
 	swagger, err := GetSwagger()
 	if err != nil {
 		return nil, errors.Wrap(err, "Error getting swagger")
 	}
 
+	// Requests are matched against the spec to validate them, and to name them
+	// in the metrics.
+	routes, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create spec router")
+	}
+
 	router := chi.NewRouter()
-	router.Use(oapiMiddleware.OapiRequestValidator(swagger))
 	router.Use(middleware.Logger)
+	router.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		WriteError(w, r, NewError(http.StatusNotFound, "not-found", "No such route"))
+	})
+	router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		WriteError(w, r, NewError(http.StatusMethodNotAllowed, "method-not-allowed", ""))
+	})
+
+	if err := mountDocs(router, swagger); err != nil {
+		return nil, err
+	}
+
+	strictHandler := NewStrictHandlerWithOptions(ssi, []StrictMiddlewareFunc{requestMiddleware}, StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			WriteError(w, r, NewError(http.StatusBadRequest, "validation", err.Error()))
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			problem := mapError(err)
+			if problem.Status >= http.StatusInternalServerError {
+				zerolog.Ctx(r.Context()).Error().Err(err).Msgf("Error handling %s %s", r.Method, r.URL.Path)
+			}
+
+			WriteError(w, r, problem)
+		},
+	})
 
-	strictHandler := NewStrictHandler(ssi, nil)
-	HandlerFromMux(strictHandler, router)
+	// Only the API itself is authenticated and validated against the spec.
+	router.Group(func(r chi.Router) {
+		r.Use(metricsMiddleware(routes))
+		r.Use(authMiddleware(authenticate))
+		r.Use(validatorMiddleware(routes))
+		HandlerFromMux(strictHandler, r)
+	})
 
 	return router, nil
 }
@@ -0,0 +1,39 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// problemTypePrefix namespaces the problem types, which are URNs as there's no
+// documentation to point them to.
+const problemTypePrefix = "urn:serverpouch:problem:"
+
+// NewError creates a problem of the given kind, such as "not-found".
+func NewError(status int, kind string, detail string) Error {
+	problem := Error{
+		Type:   problemTypePrefix + kind,
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+
+	if detail != "" {
+		problem.Detail = &detail
+	}
+
+	return problem
+}
+
+// WriteError responds with the problem as application/problem+json.
+func WriteError(w http.ResponseWriter, r *http.Request, problem Error) {
+	if problem.Instance == nil && r != nil {
+		instance := r.URL.Path
+		problem.Instance = &instance
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(problem.Status)
+
+	json.NewEncoder(w).Encode(problem)
+}
@@ -2,8 +2,12 @@ package openapi
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -19,15 +23,76 @@ func ServerToOAPI(server server.ServerInstance) (*Server, error) {
 		return nil, errors.Wrap(err, "failed to convert config to OAPI")
 	}
 
+	meta := server.Config().Metadata()
+	labels := maps.Clone(meta.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	description := meta.Description
+	var statusReason *string
+	if reason := server.StatusReason(); reason != "" {
+		statusReason = &reason
+	}
+
 	srv := &Server{
-		Config: *oCfg,
-		Id:     server.Config().ID(),
-		Status: ServerStatus(server.Status()),
+		Config:       *oCfg,
+		Id:           server.Config().ID(),
+		Name:         meta.Name,
+		Description:  &description,
+		Labels:       &labels,
+		Status:       ServerStatus(server.Status()),
+		StatusReason: statusReason,
+		Crashes:      crashesToOAPI(server.Crashes()),
+		CreatedAt:    meta.CreatedAt,
+		UpdatedAt:    meta.UpdatedAt,
 	}
 
 	return srv, nil
 }
 
+func crashesToOAPI(crashes server.CrashState) ServerCrashes {
+	oCrashes := ServerCrashes{Count: crashes.Count}
+	if !crashes.LastExit.IsZero() {
+		oCrashes.ExitCode = &crashes.ExitCode
+	}
+	if !crashes.LastCrash.IsZero() {
+		oCrashes.LastCrashAt = &crashes.LastCrash
+	}
+
+	return oCrashes
+}
+
+// MARK: ETag
+
+// ServerETag identifies the revision of the server's config.
+func ServerETag(config server.ServerInstanceConfig) string {
+	return fmt.Sprintf("\"%d\"", config.Metadata().Revision)
+}
+
+// ParseServerETag returns the revision identified by an ETag from ServerETag.
+// Weak ETags are accepted too, as revisions identify the config exactly.
+func ParseServerETag(etag string) (int64, bool) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+
+	unquoted, ok := strings.CutPrefix(etag, "\"")
+	if !ok {
+		return 0, false
+	}
+
+	unquoted, ok = strings.CutSuffix(unquoted, "\"")
+	if !ok {
+		return 0, false
+	}
+
+	revision, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return revision, true
+}
+
 // MARK: ConfigToOAPI
 
 func ConfigToOAPI(config server.ServerInstanceConfig) (*ServerConfig, error) {
@@ -42,20 +107,34 @@ func ConfigToOAPI(config server.ServerInstanceConfig) (*ServerConfig, error) {
 			Environment: config.ContainerEnv,
 			Image:       config.Image,
 			Ports:       []string{},
-			Type:        Docker,
+			Type:        ServerConfigDockerTypeDocker,
 			Volumes:     []string{},
+			Resources:   resourcesToOAPI(config.Resources),
 		}
 
-		for hostPort, containerPort := range config.ContainerPorts {
-			portStr := fmt.Sprintf("%d:%s", hostPort, containerPort)
+		if config.RestartPolicy.Mode != "" {
+			maxRetries := config.RestartPolicy.MaxRetries
+			dSrvCfg.RestartPolicy = &RestartPolicy{
+				Mode:       RestartPolicyMode(config.RestartPolicy.Mode),
+				MaxRetries: &maxRetries,
+			}
+		}
+
+		// Maps are unordered, so sort them to keep responses stable.
+		for _, hostPort := range slices.Sorted(maps.Keys(config.ContainerPorts)) {
+			portStr := fmt.Sprintf("%d:%s", hostPort, config.ContainerPorts[hostPort])
 			dSrvCfg.Ports = append(dSrvCfg.Ports, portStr)
 		}
 
-		for hostVol, containerVol := range config.ContainerVolumes {
-			volumeStr := fmt.Sprintf("%s:%s", hostVol, containerVol)
+		for _, hostVol := range slices.Sorted(maps.Keys(config.ContainerVolumes)) {
+			volumeStr := fmt.Sprintf("%s:%s", hostVol, config.ContainerVolumes[hostVol])
 			dSrvCfg.Volumes = append(dSrvCfg.Volumes, volumeStr)
 		}
 
+		if config.ReadinessProbe != nil {
+			dSrvCfg.ReadinessProbe = probeToOAPI(*config.ReadinessProbe)
+		}
+
 		srvCfg := &ServerConfig{}
 		err := srvCfg.FromServerConfigDocker(dSrvCfg)
 		if err != nil {
@@ -69,6 +148,91 @@ func ConfigToOAPI(config server.ServerInstanceConfig) (*ServerConfig, error) {
 	}
 }
 
+// resourcesToOAPI leaves out the limits that aren't set, and the resources
+// altogether without any.
+func resourcesToOAPI(resources docker.DockerResources) *DockerResources {
+	if resources == (docker.DockerResources{}) {
+		return nil
+	}
+
+	nonZero := func(value int64) *int64 {
+		if value == 0 {
+			return nil
+		}
+		return &value
+	}
+
+	oResources := &DockerResources{
+		Memory:            nonZero(resources.Memory),
+		MemoryReservation: nonZero(resources.MemoryReservation),
+		MemorySwap:        nonZero(resources.MemorySwap),
+		CpuShares:         nonZero(resources.CPUShares),
+		PidsLimit:         nonZero(resources.PIDsLimit),
+	}
+	if resources.CPUs != 0 {
+		oResources.Cpus = &resources.CPUs
+	}
+	if resources.CPUSet != "" {
+		oResources.Cpuset = &resources.CPUSet
+	}
+
+	return oResources
+}
+
+func probeToOAPI(probe server.ReadinessProbe) *ReadinessProbe {
+	oProbe := &ReadinessProbe{Type: ReadinessProbeType(probe.Type)}
+
+	switch probe.Type {
+	case server.ReadinessProbeTypeTCP:
+		oProbe.Port = &probe.Port
+	case server.ReadinessProbeTypeHTTP:
+		oProbe.Port = &probe.Port
+		oProbe.Path = &probe.Path
+	case server.ReadinessProbeTypeConsole:
+		oProbe.Pattern = &probe.Pattern
+	case server.ReadinessProbeTypeExec:
+		oProbe.Command = &probe.Command
+	}
+
+	if probe.Interval != 0 {
+		interval := int(probe.Interval / time.Second)
+		oProbe.Interval = &interval
+	}
+	if probe.Timeout != 0 {
+		timeout := int(probe.Timeout / time.Second)
+		oProbe.Timeout = &timeout
+	}
+
+	return oProbe
+}
+
+// MARK: NewServerToConfig
+
+func NewServerToConfig(srv NewServer) (server.ServerInstanceConfig, error) {
+	config, err := OAPIToConfig(srv.Config)
+	if err != nil {
+		// Point config errors at their place within the new server.
+		var configErr *server.InvalidConfigError
+		if errors.As(err, &configErr) {
+			return nil, &server.InvalidConfigError{Field: "/config" + configErr.Field, Reason: configErr.Reason}
+		}
+
+		return nil, err
+	}
+
+	meta := config.Metadata()
+	meta.Name = srv.Name
+	if srv.Description != nil {
+		meta.Description = *srv.Description
+	}
+	meta.Labels = map[string]string{}
+	if srv.Labels != nil {
+		meta.Labels = maps.Clone(*srv.Labels)
+	}
+
+	return config, nil
+}
+
 // MARK: OAPIToConfig
 
 func OAPIToConfig(config ServerConfig) (server.ServerInstanceConfig, error) {
@@ -76,7 +240,7 @@ func OAPIToConfig(config ServerConfig) (server.ServerInstanceConfig, error) {
 		return dockerOAPIToConfig(dockerCfg)
 	}
 
-	return nil, errors.New("unknown config")
+	return nil, &server.InvalidConfigError{Field: "", Reason: "unknown config type"}
 }
 
 // MARK: - dockerOAPIToConfig
@@ -93,6 +257,19 @@ var (
 	// Pattern for Docker environment variables: "KEY=value"
 	// Example: "PORT=8080"
 	dockerEnvPattern = regexp.MustCompile(`^\w+=.+$`)
+
+	// Pattern for Docker cpusets: a list of CPUs and ranges of them
+	// Example: "0-3,6"
+	dockerCPUSetPattern = regexp.MustCompile(`^\d+(?:-\d+)?(?:,\d+(?:-\d+)?)*$`)
+)
+
+const (
+	// The least memory docker will run a container with.
+	dockerMinMemory = 6 * 1024 * 1024
+
+	// The range of CPU shares docker accepts.
+	dockerMinCPUShares = 2
+	dockerMaxCPUShares = 262144
 )
 
 func dockerOAPIToConfig(config ServerConfigDocker) (*docker.DockerServerInstanceOptions, error) {
@@ -103,37 +280,242 @@ func dockerOAPIToConfig(config ServerConfigDocker) (*docker.DockerServerInstance
 		ContainerEnv:     []string{},
 	}
 
-	for _, port := range config.Ports {
+	for i, port := range config.Ports {
 		portMatches := dockerPortPattern.FindStringSubmatch(port)
 		if portMatches == nil {
-			return nil, fmt.Errorf("invalid port config: %s", port)
+			return nil, &server.InvalidConfigError{
+				Field:  fmt.Sprintf("/ports/%d", i),
+				Reason: fmt.Sprintf("%q must be of the form hostPort:containerPort/protocol", port),
+			}
 		}
 
 		hostPort, err := strconv.Atoi(portMatches[1])
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert host port to int")
+		if err != nil || !validPort(hostPort) {
+			return nil, &server.InvalidConfigError{
+				Field:  fmt.Sprintf("/ports/%d", i),
+				Reason: fmt.Sprintf("host port %s is out of range", portMatches[1]),
+			}
+		}
+
+		containerPort, err := strconv.Atoi(strings.SplitN(portMatches[2], "/", 2)[0])
+		if err != nil || !validPort(containerPort) {
+			return nil, &server.InvalidConfigError{
+				Field:  fmt.Sprintf("/ports/%d", i),
+				Reason: fmt.Sprintf("container port %s is out of range", portMatches[2]),
+			}
 		}
 
 		dockerOpts.ContainerPorts[hostPort] = portMatches[2]
 	}
 
-	for _, volume := range config.Volumes {
+	for i, volume := range config.Volumes {
 		volumeMatches := dockerVolumePattern.FindStringSubmatch(volume)
 		if volumeMatches == nil {
-			return nil, fmt.Errorf("invalid volume config: %s", volume)
+			return nil, &server.InvalidConfigError{
+				Field:  fmt.Sprintf("/volumes/%d", i),
+				Reason: fmt.Sprintf("%q must be of the form hostPath:containerPath", volume),
+			}
 		}
 
 		dockerOpts.ContainerVolumes[volumeMatches[1]] = volumeMatches[2]
 	}
 
-	for _, env := range config.Environment {
+	for i, env := range config.Environment {
 		envMatch := dockerEnvPattern.FindString(env)
 		if envMatch == "" {
-			return nil, fmt.Errorf("invalid environment config: %s", env)
+			return nil, &server.InvalidConfigError{
+				Field:  fmt.Sprintf("/environment/%d", i),
+				Reason: fmt.Sprintf("%q must be of the form KEY=value", env),
+			}
 		}
 
 		dockerOpts.ContainerEnv = append(dockerOpts.ContainerEnv, envMatch)
 	}
 
+	if config.RestartPolicy != nil {
+		switch config.RestartPolicy.Mode {
+		case RestartPolicyModeNever, RestartPolicyModeOnFailure, RestartPolicyModeAlways:
+		default:
+			return nil, &server.InvalidConfigError{
+				Field:  "/restartPolicy/mode",
+				Reason: fmt.Sprintf("%q must be never, on-failure or always", config.RestartPolicy.Mode),
+			}
+		}
+
+		dockerOpts.RestartPolicy.Mode = server.RestartMode(config.RestartPolicy.Mode)
+		if config.RestartPolicy.MaxRetries != nil {
+			if *config.RestartPolicy.MaxRetries < 0 {
+				return nil, &server.InvalidConfigError{
+					Field:  "/restartPolicy/maxRetries",
+					Reason: "max retries must not be negative",
+				}
+			}
+
+			dockerOpts.RestartPolicy.MaxRetries = *config.RestartPolicy.MaxRetries
+		}
+	}
+
+	if config.ReadinessProbe != nil {
+		probe, err := dockerOAPIToProbe(*config.ReadinessProbe, dockerOpts.ContainerPorts)
+		if err != nil {
+			return nil, err
+		}
+
+		dockerOpts.ReadinessProbe = probe
+	}
+
+	if config.Resources != nil {
+		resources, err := dockerOAPIToResources(*config.Resources)
+		if err != nil {
+			return nil, err
+		}
+
+		dockerOpts.Resources = resources
+	}
+
 	return dockerOpts, nil
 }
+
+func dockerOAPIToProbe(oProbe ReadinessProbe, ports map[int]string) (*server.ReadinessProbe, error) {
+	probe := &server.ReadinessProbe{Type: server.ReadinessProbeType(oProbe.Type)}
+	invalid := func(field string, reason string, args ...any) (*server.ReadinessProbe, error) {
+		return nil, &server.InvalidConfigError{
+			Field:  "/readinessProbe/" + field,
+			Reason: fmt.Sprintf(reason, args...),
+		}
+	}
+
+	switch oProbe.Type {
+	case ReadinessProbeTypeTcp, ReadinessProbeTypeHttp:
+		if oProbe.Port == nil {
+			return invalid("port", "%s probes require a port", oProbe.Type)
+		}
+
+		// Probes connect from the host, so only published TCP ports can be reached.
+		probe.Port = *oProbe.Port
+		if !strings.HasSuffix(ports[probe.Port], "/tcp") {
+			return invalid("port", "host port %d must be published over tcp", probe.Port)
+		}
+
+		if oProbe.Type == ReadinessProbeTypeHttp {
+			probe.Path = "/"
+			if oProbe.Path != nil {
+				probe.Path = *oProbe.Path
+			}
+
+			if !strings.HasPrefix(probe.Path, "/") {
+				return invalid("path", "%q must begin with /", probe.Path)
+			}
+		}
+
+	case ReadinessProbeTypeConsole:
+		if oProbe.Pattern == nil || *oProbe.Pattern == "" {
+			return invalid("pattern", "console probes require a pattern")
+		}
+
+		probe.Pattern = *oProbe.Pattern
+		if _, err := regexp.Compile(probe.Pattern); err != nil {
+			return invalid("pattern", "%q isn't a valid regular expression: %s", probe.Pattern, err)
+		}
+
+	case ReadinessProbeTypeExec:
+		if oProbe.Command == nil || len(*oProbe.Command) == 0 {
+			return invalid("command", "exec probes require a command")
+		}
+
+		probe.Command = *oProbe.Command
+
+	default:
+		return invalid("type", "%q must be tcp, http, console or exec", oProbe.Type)
+	}
+
+	if oProbe.Interval != nil {
+		if *oProbe.Interval < 1 {
+			return invalid("interval", "interval must be at least a second")
+		}
+
+		probe.Interval = time.Duration(*oProbe.Interval) * time.Second
+	}
+
+	if oProbe.Timeout != nil {
+		if *oProbe.Timeout < 1 {
+			return invalid("timeout", "timeout must be at least a second")
+		}
+
+		probe.Timeout = time.Duration(*oProbe.Timeout) * time.Second
+	}
+
+	return probe, nil
+}
+
+func dockerOAPIToResources(oResources DockerResources) (docker.DockerResources, error) {
+	resources := docker.DockerResources{}
+	invalid := func(field string, reason string, args ...any) (docker.DockerResources, error) {
+		return docker.DockerResources{}, &server.InvalidConfigError{
+			Field:  "/resources/" + field,
+			Reason: fmt.Sprintf(reason, args...),
+		}
+	}
+
+	if oResources.Memory != nil {
+		resources.Memory = *oResources.Memory
+		if resources.Memory < dockerMinMemory {
+			return invalid("memory", "memory limit must be at least %d bytes", dockerMinMemory)
+		}
+	}
+
+	if oResources.MemoryReservation != nil {
+		resources.MemoryReservation = *oResources.MemoryReservation
+		switch {
+		case resources.MemoryReservation <= 0:
+			return invalid("memoryReservation", "memory reservation must be positive")
+		case resources.Memory != 0 && resources.MemoryReservation > resources.Memory:
+			return invalid("memoryReservation", "memory reservation must not exceed the memory limit")
+		}
+	}
+
+	if oResources.MemorySwap != nil {
+		resources.MemorySwap = *oResources.MemorySwap
+		switch {
+		case resources.Memory == 0:
+			return invalid("memorySwap", "swap limit requires a memory limit")
+		case resources.MemorySwap != -1 && resources.MemorySwap < resources.Memory:
+			return invalid("memorySwap", "swap limit must be -1 or at least the memory limit")
+		}
+	}
+
+	if oResources.CpuShares != nil {
+		resources.CPUShares = *oResources.CpuShares
+		if resources.CPUShares < dockerMinCPUShares || resources.CPUShares > dockerMaxCPUShares {
+			return invalid("cpuShares", "CPU shares must be between %d and %d", dockerMinCPUShares, dockerMaxCPUShares)
+		}
+	}
+
+	if oResources.Cpus != nil {
+		resources.CPUs = *oResources.Cpus
+		// Docker's quota is in billionths of a CPU, so anything finer is lost.
+		if resources.CPUs < 1e-9 {
+			return invalid("cpus", "CPU quota must be positive")
+		}
+	}
+
+	if oResources.Cpuset != nil {
+		resources.CPUSet = *oResources.Cpuset
+		if !dockerCPUSetPattern.MatchString(resources.CPUSet) {
+			return invalid("cpuset", "%q must be a list of CPUs or ranges of them, such as 0-3,6", resources.CPUSet)
+		}
+	}
+
+	if oResources.PidsLimit != nil {
+		resources.PIDsLimit = *oResources.PidsLimit
+		if resources.PIDsLimit <= 0 {
+			return invalid("pidsLimit", "PIDs limit must be positive")
+		}
+	}
+
+	return resources, nil
+}
+
+func validPort(port int) bool {
+	return port > 0 && port <= 65535
+}
@@ -2,14 +2,54 @@ package openapi_test
 
 import (
 	"testing"
+	"time"
 
 	"oppossome/serverpouch/internal/delivery/http/openapi"
 	"oppossome/serverpouch/internal/domain/server"
 	"oppossome/serverpouch/internal/infrastructure/docker"
 
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestServerToOAPI(t *testing.T) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cfg := &docker.DockerServerInstanceOptions{
+		InstanceID: uuid.New(),
+		Meta: server.ServerInstanceMetadata{
+			Name:        "survival",
+			Description: "The main survival world",
+			Labels:      map[string]string{"game": "minecraft"},
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt.Add(time.Hour),
+		},
+		Image:        "itzg/minecraft-server",
+		ContainerEnv: []string{},
+	}
+
+	inst := mockServer.NewMockServerInstance(t)
+	inst.EXPECT().Config().Return(cfg)
+	inst.EXPECT().Status().Return(server.ServerInstanceStatusIdle)
+	inst.EXPECT().StatusReason().Return("")
+	inst.EXPECT().Crashes().Return(server.CrashState{ExitCode: 1, LastExit: createdAt, Count: 2, LastCrash: createdAt})
+
+	srv, err := openapi.ServerToOAPI(inst)
+	assert.NoError(t, err)
+
+	assert.Equal(t, cfg.InstanceID, srv.Id)
+	assert.Equal(t, "survival", srv.Name)
+	assert.Equal(t, "The main survival world", *srv.Description)
+	assert.Equal(t, map[string]string{"game": "minecraft"}, *srv.Labels)
+	assert.Equal(t, createdAt, srv.CreatedAt)
+	assert.Equal(t, createdAt.Add(time.Hour), srv.UpdatedAt)
+	assert.Equal(t, openapi.Idle, srv.Status)
+	assert.Equal(t, 2, srv.Crashes.Count)
+	assert.Equal(t, 1, *srv.Crashes.ExitCode)
+	assert.Equal(t, createdAt, *srv.Crashes.LastCrashAt)
+}
+
 func TestConfigToOAPI(t *testing.T) {
 	dockerTests := []struct {
 		name   string
@@ -28,10 +68,29 @@ func TestConfigToOAPI(t *testing.T) {
 				Environment: []string{"PORT=8080"},
 				Image:       "test",
 				Ports:       []string{"80:8080/tcp", "81:8081/udp"},
-				Type:        openapi.Docker,
+				Type:        openapi.ServerConfigDockerTypeDocker,
 				Volumes:     []string{"/host:/container"},
 			},
 		},
+		{
+			name: "Ok - Resources",
+			config: docker.DockerServerInstanceOptions{
+				Image:     "test",
+				Resources: docker.DockerResources{Memory: 1 << 30, CPUs: 1.5, CPUSet: "0-3"},
+			},
+			want: openapi.ServerConfigDocker{
+				Environment: nil,
+				Image:       "test",
+				Ports:       []string{},
+				Type:        openapi.ServerConfigDockerTypeDocker,
+				Volumes:     []string{},
+				Resources: &openapi.DockerResources{
+					Memory: ptr(int64(1 << 30)),
+					Cpus:   ptr(1.5),
+					Cpuset: ptr("0-3"),
+				},
+			},
+		},
 	}
 
 	for _, tt := range dockerTests {
@@ -51,7 +110,7 @@ func TestOAPIToConfig(t *testing.T) {
 		name      string
 		config    openapi.ServerConfigDocker
 		want      server.ServerInstanceConfig
-		wantError string
+		wantError *server.InvalidConfigError
 	}{
 		{
 			name: "Ok",
@@ -59,7 +118,7 @@ func TestOAPIToConfig(t *testing.T) {
 				Environment: []string{"PORT=8080"},
 				Image:       "test",
 				Ports:       []string{"80:8080/tcp", "81:8081/udp"},
-				Type:        openapi.Docker,
+				Type:        openapi.ServerConfigDockerTypeDocker,
 				Volumes:     []string{"/host:/container"},
 			},
 			want: &docker.DockerServerInstanceOptions{
@@ -75,10 +134,10 @@ func TestOAPIToConfig(t *testing.T) {
 				Environment: []string{"invalid"},
 				Image:       "test",
 				Ports:       []string{},
-				Type:        openapi.Docker,
+				Type:        openapi.ServerConfigDockerTypeDocker,
 				Volumes:     []string{},
 			},
-			wantError: "invalid environment config: invalid",
+			wantError: &server.InvalidConfigError{Field: "/environment/0", Reason: `"invalid" must be of the form KEY=value`},
 		},
 		{
 			name: "Invalid Port",
@@ -86,10 +145,21 @@ func TestOAPIToConfig(t *testing.T) {
 				Environment: []string{},
 				Image:       "test",
 				Ports:       []string{"invalid"},
-				Type:        openapi.Docker,
+				Type:        openapi.ServerConfigDockerTypeDocker,
+				Volumes:     []string{},
+			},
+			wantError: &server.InvalidConfigError{Field: "/ports/0", Reason: `"invalid" must be of the form hostPort:containerPort/protocol`},
+		},
+		{
+			name: "Port Out Of Range",
+			config: openapi.ServerConfigDocker{
+				Environment: []string{},
+				Image:       "test",
+				Ports:       []string{"80:8080/tcp", "70000:8080/tcp"},
+				Type:        openapi.ServerConfigDockerTypeDocker,
 				Volumes:     []string{},
 			},
-			wantError: "invalid port config: invalid",
+			wantError: &server.InvalidConfigError{Field: "/ports/1", Reason: "host port 70000 is out of range"},
 		},
 		{
 			name: "Invalid Volume",
@@ -97,10 +167,171 @@ func TestOAPIToConfig(t *testing.T) {
 				Environment: []string{},
 				Image:       "test",
 				Ports:       []string{},
-				Type:        openapi.Docker,
+				Type:        openapi.ServerConfigDockerTypeDocker,
 				Volumes:     []string{"invalid"},
 			},
-			wantError: "invalid volume config: invalid",
+			wantError: &server.InvalidConfigError{Field: "/volumes/0", Reason: `"invalid" must be of the form hostPath:containerPath`},
+		},
+		{
+			name: "Ok - Resources",
+			config: openapi.ServerConfigDocker{
+				Environment: []string{},
+				Image:       "test",
+				Ports:       []string{},
+				Type:        openapi.ServerConfigDockerTypeDocker,
+				Volumes:     []string{},
+				Resources: &openapi.DockerResources{
+					Memory:     ptr(int64(1 << 30)),
+					MemorySwap: ptr(int64(-1)),
+					CpuShares:  ptr(int64(512)),
+					Cpuset:     ptr("0,2-3"),
+					PidsLimit:  ptr(int64(256)),
+				},
+			},
+			want: &docker.DockerServerInstanceOptions{
+				Image:            "test",
+				ContainerEnv:     []string{},
+				ContainerPorts:   map[int]string{},
+				ContainerVolumes: map[string]string{},
+				Resources: docker.DockerResources{
+					Memory:     1 << 30,
+					MemorySwap: -1,
+					CPUShares:  512,
+					CPUSet:     "0,2-3",
+					PIDsLimit:  256,
+				},
+			},
+		},
+		{
+			name: "Ok - Restart Policy",
+			config: openapi.ServerConfigDocker{
+				Environment:   []string{},
+				Image:         "test",
+				Ports:         []string{},
+				Type:          openapi.ServerConfigDockerTypeDocker,
+				Volumes:       []string{},
+				RestartPolicy: &openapi.RestartPolicy{Mode: openapi.RestartPolicyModeOnFailure, MaxRetries: ptr(5)},
+			},
+			want: &docker.DockerServerInstanceOptions{
+				Image:            "test",
+				ContainerEnv:     []string{},
+				ContainerPorts:   map[int]string{},
+				ContainerVolumes: map[string]string{},
+				RestartPolicy:    server.RestartPolicy{Mode: server.RestartModeOnFailure, MaxRetries: 5},
+			},
+		},
+		{
+			name: "Invalid Restart Mode",
+			config: openapi.ServerConfigDocker{
+				Environment:   []string{},
+				Image:         "test",
+				Ports:         []string{},
+				Type:          openapi.ServerConfigDockerTypeDocker,
+				Volumes:       []string{},
+				RestartPolicy: &openapi.RestartPolicy{Mode: "sometimes"},
+			},
+			wantError: &server.InvalidConfigError{Field: "/restartPolicy/mode", Reason: `"sometimes" must be never, on-failure or always`},
+		},
+		{
+			name: "Ok - Readiness Probe",
+			config: openapi.ServerConfigDocker{
+				Environment:    []string{},
+				Image:          "test",
+				Ports:          []string{"25565:25565/tcp"},
+				Type:           openapi.ServerConfigDockerTypeDocker,
+				Volumes:        []string{},
+				ReadinessProbe: &openapi.ReadinessProbe{Type: openapi.ReadinessProbeTypeHttp, Port: ptr(25565), Timeout: ptr(300)},
+			},
+			want: &docker.DockerServerInstanceOptions{
+				Image:            "test",
+				ContainerEnv:     []string{},
+				ContainerPorts:   map[int]string{25565: "25565/tcp"},
+				ContainerVolumes: map[string]string{},
+				ReadinessProbe: &server.ReadinessProbe{
+					Type:    server.ReadinessProbeTypeHTTP,
+					Port:    25565,
+					Path:    "/",
+					Timeout: 5 * time.Minute,
+				},
+			},
+		},
+		{
+			name: "Probe Port Not Published",
+			config: openapi.ServerConfigDocker{
+				Environment:    []string{},
+				Image:          "test",
+				Ports:          []string{"25565:25565/udp"},
+				Type:           openapi.ServerConfigDockerTypeDocker,
+				Volumes:        []string{},
+				ReadinessProbe: &openapi.ReadinessProbe{Type: openapi.ReadinessProbeTypeTcp, Port: ptr(25565)},
+			},
+			wantError: &server.InvalidConfigError{Field: "/readinessProbe/port", Reason: "host port 25565 must be published over tcp"},
+		},
+		{
+			name: "Invalid Probe Pattern",
+			config: openapi.ServerConfigDocker{
+				Environment:    []string{},
+				Image:          "test",
+				Ports:          []string{},
+				Type:           openapi.ServerConfigDockerTypeDocker,
+				Volumes:        []string{},
+				ReadinessProbe: &openapi.ReadinessProbe{Type: openapi.ReadinessProbeTypeConsole, Pattern: ptr("Done (")},
+			},
+			wantError: &server.InvalidConfigError{
+				Field:  "/readinessProbe/pattern",
+				Reason: "\"Done (\" isn't a valid regular expression: error parsing regexp: missing closing ): `Done (`",
+			},
+		},
+		{
+			name: "Memory Too Low",
+			config: openapi.ServerConfigDocker{
+				Environment: []string{},
+				Image:       "test",
+				Ports:       []string{},
+				Type:        openapi.ServerConfigDockerTypeDocker,
+				Volumes:     []string{},
+				Resources:   &openapi.DockerResources{Memory: ptr(int64(1024))},
+			},
+			wantError: &server.InvalidConfigError{Field: "/resources/memory", Reason: "memory limit must be at least 6291456 bytes"},
+		},
+		{
+			name: "Reservation Over Limit",
+			config: openapi.ServerConfigDocker{
+				Environment: []string{},
+				Image:       "test",
+				Ports:       []string{},
+				Type:        openapi.ServerConfigDockerTypeDocker,
+				Volumes:     []string{},
+				Resources: &openapi.DockerResources{
+					Memory:            ptr(int64(1 << 30)),
+					MemoryReservation: ptr(int64(2 << 30)),
+				},
+			},
+			wantError: &server.InvalidConfigError{Field: "/resources/memoryReservation", Reason: "memory reservation must not exceed the memory limit"},
+		},
+		{
+			name: "Swap Without Memory",
+			config: openapi.ServerConfigDocker{
+				Environment: []string{},
+				Image:       "test",
+				Ports:       []string{},
+				Type:        openapi.ServerConfigDockerTypeDocker,
+				Volumes:     []string{},
+				Resources:   &openapi.DockerResources{MemorySwap: ptr(int64(1 << 30))},
+			},
+			wantError: &server.InvalidConfigError{Field: "/resources/memorySwap", Reason: "swap limit requires a memory limit"},
+		},
+		{
+			name: "Invalid CPU Set",
+			config: openapi.ServerConfigDocker{
+				Environment: []string{},
+				Image:       "test",
+				Ports:       []string{},
+				Type:        openapi.ServerConfigDockerTypeDocker,
+				Volumes:     []string{},
+				Resources:   &openapi.DockerResources{Cpuset: ptr("all")},
+			},
+			wantError: &server.InvalidConfigError{Field: "/resources/cpuset", Reason: `"all" must be a list of CPUs or ranges of them, such as 0-3,6`},
 		},
 	}
 
@@ -116,9 +347,13 @@ func TestOAPIToConfig(t *testing.T) {
 				assert.Equal(t, dt.want, cfg)
 			}
 
-			if dt.wantError != "" {
-				assert.Equal(t, dt.wantError, err.Error())
+			if dt.wantError != nil {
+				assert.Equal(t, dt.wantError, err)
 			}
 		})
 	}
 }
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,19 @@
+package openapi
+
+import "oppossome/serverpouch/internal/domain/server"
+
+// MARK: StatsToOAPI
+
+func StatsToOAPI(stats server.Stats) ServerStats {
+	return ServerStats{
+		Time:        stats.Time,
+		CpuPercent:  stats.CPUPercent,
+		MemoryUsage: stats.MemoryUsage,
+		MemoryLimit: stats.MemoryLimit,
+		NetworkRx:   stats.NetworkRx,
+		NetworkTx:   stats.NetworkTx,
+		BlockRead:   stats.BlockRead,
+		BlockWrite:  stats.BlockWrite,
+		Pids:        stats.PIDs,
+	}
+}
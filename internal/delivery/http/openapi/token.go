@@ -0,0 +1,16 @@
+package openapi
+
+import "oppossome/serverpouch/internal/domain/auth"
+
+// MARK: TokenToOAPI
+
+func TokenToOAPI(token *auth.APIToken) APIToken {
+	return APIToken{
+		Id:         token.ID,
+		UserId:     token.UserID,
+		Name:       token.Name,
+		CreatedAt:  token.CreatedAt,
+		LastUsedAt: token.LastUsedAt,
+		RevokedAt:  token.RevokedAt,
+	}
+}
@@ -0,0 +1,30 @@
+package openapi
+
+import (
+	"bytes"
+	"slices"
+
+	"oppossome/serverpouch/internal/domain/auth"
+)
+
+// MARK: UserToOAPI
+
+func UserToOAPI(user *auth.User) User {
+	grants := make([]ServerGrant, 0, len(user.Grants))
+	for serverID, role := range user.Grants {
+		grants = append(grants, ServerGrant{ServerId: serverID, Role: ServerRole(role)})
+	}
+
+	// Keep the order stable, the grants come from a map.
+	slices.SortFunc(grants, func(a, b ServerGrant) int {
+		return bytes.Compare(a.ServerId[:], b.ServerId[:])
+	})
+
+	return User{
+		Id:        user.ID,
+		Name:      user.Name,
+		IsAdmin:   user.IsAdmin,
+		CreatedAt: user.CreatedAt,
+		Grants:    grants,
+	}
+}
@@ -0,0 +1,129 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/pkg/errors"
+)
+
+// validatorMiddleware validates requests against the spec, responding with a
+// problem listing every invalid field when they don't match.
+func validatorMiddleware(routes routers.Router) func(http.Handler) http.Handler {
+	options := &openapi3filter.Options{
+		AuthenticationFunc: authenticationFunc,
+		MultiError:         true,
+	}
+
+	// Validating a body reads all of it into memory first, so uploads are
+	// left to stream through untouched.
+	streamOptions := *options
+	streamOptions.ExcludeRequestBody = true
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := routes.FindRoute(r)
+			switch {
+			case errors.Is(err, routers.ErrMethodNotAllowed):
+				WriteError(w, r, NewError(http.StatusMethodNotAllowed, "method-not-allowed", ""))
+				return
+			case err != nil:
+				WriteError(w, r, NewError(http.StatusNotFound, "not-found", "No such route"))
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if r.Header.Get("Content-Type") == "application/octet-stream" {
+				input.Options = &streamOptions
+			}
+
+			err = openapi3filter.ValidateRequest(r.Context(), input)
+			if err != nil {
+				problem := validationProblem(err)
+				if problem.Status == http.StatusUnauthorized {
+					w.Header().Set("WWW-Authenticate", "Bearer")
+				}
+
+				WriteError(w, r, problem)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func validationProblem(err error) Error {
+	var securityErr *openapi3filter.SecurityRequirementsError
+	if errors.As(err, &securityErr) {
+		return unauthorizedProblem("Missing bearer token")
+	}
+
+	problem := NewError(http.StatusBadRequest, "validation", "The request is invalid")
+	fieldErrors := collectFieldErrors("", err)
+	problem.Errors = &fieldErrors
+
+	// Authentication is checked alongside the request, so it may be hidden
+	// among other errors.
+	for _, fieldErr := range fieldErrors {
+		if fieldErr.Field == "Authorization" {
+			return unauthorizedProblem("Missing bearer token")
+		}
+	}
+
+	return problem
+}
+
+// collectFieldErrors flattens the validator's nested errors, naming each by
+// its parameter or its JSON pointer into the body. The errors are matched by
+// type rather than errors.As, as that would skip past the parameter they wrap.
+func collectFieldErrors(field string, err error) []FieldError {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		fieldErrors := []FieldError{}
+		for _, err := range err {
+			fieldErrors = append(fieldErrors, collectFieldErrors(field, err)...)
+		}
+		return fieldErrors
+
+	case *openapi3filter.SecurityRequirementsError:
+		return []FieldError{{Field: "Authorization", Message: "Missing bearer token"}}
+
+	case *openapi3filter.RequestError:
+		if err.Parameter != nil {
+			field = err.Parameter.Name
+		}
+
+		if err.Err == nil {
+			return []FieldError{{Field: field, Message: err.Reason}}
+		}
+
+		return collectFieldErrors(field, err.Err)
+
+	case *openapi3.SchemaError:
+		if pointer := err.JSONPointer(); len(pointer) > 0 && field == "" {
+			field = "/" + strings.Join(pointer, "/")
+		}
+
+		return []FieldError{{Field: field, Message: err.Reason}}
+
+	case *openapi3filter.ParseError:
+		return []FieldError{{Field: field, Message: err.Error()}}
+
+	default:
+		return []FieldError{{Field: field, Message: fmt.Sprint(err)}}
+	}
+}
+
+func unauthorizedProblem(detail string) Error {
+	return NewError(http.StatusUnauthorized, "unauthorized", detail)
+}
@@ -2,17 +2,26 @@ package http
 
 import (
 	"context"
+	"strings"
 
 	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/domain/usecases"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	"github.com/rs/zerolog"
 )
 
 // Create a new server
 // (POST /api/servers)
 func (hi *httpImpl) CreateServer(ctx context.Context, request openapi.CreateServerRequestObject) (openapi.CreateServerResponseObject, error) {
-	instCfg, err := openapi.OAPIToConfig(request.Body.Config)
+	// Servers can bind mount arbitrary host paths, so only admins may create them.
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	instCfg, err := openapi.NewServerToConfig(*request.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to decode openapi server")
 	}
@@ -28,16 +37,22 @@ func (hi *httpImpl) CreateServer(ctx context.Context, request openapi.CreateServ
 		return nil, errors.Wrap(err, "failed to encode openapi server")
 	}
 
-	return openapi.CreateServer201JSONResponse{Server: *oInst}, nil
+	return openapi.CreateServer201JSONResponse{
+		Body:    openapi.ServerResponse{Server: *oInst},
+		Headers: openapi.CreateServer201ResponseHeaders{ETag: openapi.ServerETag(inst.Config())},
+	}, nil
 }
 
 // Get a server by ID
 // (GET /api/servers/{id})
 func (hi *httpImpl) GetServer(ctx context.Context, request openapi.GetServerRequestObject) (openapi.GetServerResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionView); err != nil {
+		return nil, err
+	}
+
 	inst, err := hi.usecases.GetServer(ctx, request.Id)
 	if err != nil {
-		zerolog.Ctx(ctx).Err(err).Msgf("Failed to get server of id %s", request.Id)
-		return openapi.GetServer404Response{}, nil
+		return nil, errors.Wrap(err, "failed to get server")
 	}
 
 	oInst, err := openapi.ServerToOAPI(inst)
@@ -45,13 +60,21 @@ func (hi *httpImpl) GetServer(ctx context.Context, request openapi.GetServerRequ
 		return nil, errors.Wrap(err, "failed to encode openapi server")
 	}
 
-	return openapi.GetServer200JSONResponse{Server: *oInst}, nil
+	return openapi.GetServer200JSONResponse{
+		Body:    openapi.ServerResponse{Server: *oInst},
+		Headers: openapi.GetServer200ResponseHeaders{ETag: openapi.ServerETag(inst.Config())},
+	}, nil
 }
 
 // List all servers
 // (GET /api/servers)
 func (hi *httpImpl) ListServers(ctx context.Context, request openapi.ListServersRequestObject) (openapi.ListServersResponseObject, error) {
-	insts := hi.usecases.ListServers(ctx)
+	user, _ := auth.UserFromContext(ctx)
+
+	insts, nextCursor, err := hi.usecases.ListServers(ctx, listServersOptions(user, request.Params))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list servers")
+	}
 
 	oInsts := make([]openapi.Server, len(insts))
 	for idx, inst := range insts {
@@ -63,5 +86,243 @@ func (hi *httpImpl) ListServers(ctx context.Context, request openapi.ListServers
 		oInsts[idx] = *oInst
 	}
 
+	if nextCursor != "" {
+		return openapi.ListServers200JSONResponse{Servers: oInsts, NextCursor: &nextCursor}, nil
+	}
+
 	return openapi.ListServers200JSONResponse{Servers: oInsts}, nil
 }
+
+func listServersOptions(user *auth.User, params openapi.ListServersParams) usecases.ListServersOptions {
+	opts := usecases.ListServersOptions{
+		User:   user,
+		Labels: map[string]string{},
+	}
+
+	if params.Status != nil {
+		for _, status := range *params.Status {
+			opts.Statuses = append(opts.Statuses, server.ServerInstanceStatus(status))
+		}
+	}
+
+	if params.Type != nil {
+		for _, instType := range *params.Type {
+			opts.Types = append(opts.Types, server.ServerInstanceType(instType))
+		}
+	}
+
+	if params.Image != nil {
+		opts.Image = *params.Image
+	}
+
+	if params.Label != nil {
+		for _, label := range *params.Label {
+			key, value, _ := strings.Cut(label, "=")
+			opts.Labels[key] = value
+		}
+	}
+
+	if params.Sort != nil {
+		opts.Sort = usecases.ServerSort(*params.Sort)
+	}
+
+	if params.Order != nil {
+		opts.Descending = *params.Order == openapi.SortOrderDesc
+	}
+
+	if params.Cursor != nil {
+		opts.Cursor = *params.Cursor
+	}
+
+	if params.Limit != nil {
+		opts.Limit = *params.Limit
+	}
+
+	return opts
+}
+
+// Update a server's configuration
+// (PUT /api/servers/{id})
+func (hi *httpImpl) UpdateServer(ctx context.Context, request openapi.UpdateServerRequestObject) (openapi.UpdateServerResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionConfigure); err != nil {
+		return nil, err
+	}
+
+	if request.Params.IfMatch == nil {
+		return nil, errors.WithStack(errPreconditionRequired)
+	}
+
+	revision, err := hi.ifMatchRevision(ctx, request.Id, *request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	instCfg, err := openapi.NewServerToConfig(*request.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode openapi server")
+	}
+	instCfg.Metadata().Revision = revision
+
+	if err := hi.authorizeHostAccess(ctx, request.Id, instCfg); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.UpdateServer(ctx, request.Id, instCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update server")
+	}
+
+	oInst, err := openapi.ServerToOAPI(inst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode openapi server")
+	}
+
+	return openapi.UpdateServer200JSONResponse{
+		Body:    openapi.ServerResponse{Server: *oInst},
+		Headers: openapi.UpdateServer200ResponseHeaders{ETag: openapi.ServerETag(inst.Config())},
+	}, nil
+}
+
+// ifMatchRevision returns the revision an update is made against. An If-Match
+// of * matches whichever revision is current.
+func (hi *httpImpl) ifMatchRevision(ctx context.Context, id uuid.UUID, ifMatch string) (int64, error) {
+	if strings.TrimSpace(ifMatch) == "*" {
+		inst, err := hi.usecases.GetServer(ctx, id)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get server")
+		}
+
+		return inst.Config().Metadata().Revision, nil
+	}
+
+	// An ETag we didn't issue can't match any revision.
+	revision, ok := openapi.ParseServerETag(ifMatch)
+	if !ok {
+		return 0, errors.Wrapf(server.ErrRevisionMismatch, "unknown ETag %s", ifMatch)
+	}
+
+	return revision, nil
+}
+
+// authorizeHostAccess checks the caller is an admin if config changes how the
+// server reaches into the host, as a bind mount can expose any path on it.
+func (hi *httpImpl) authorizeHostAccess(ctx context.Context, id uuid.UUID, config server.ServerInstanceConfig) error {
+	if user, ok := auth.UserFromContext(ctx); ok && user.IsAdmin {
+		return nil
+	}
+
+	inst, err := hi.usecases.GetServer(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to get server")
+	}
+
+	if !inst.Config().HostAccessEqual(config) {
+		return errors.Wrap(auth.ErrForbidden, "only admins may change the image, volumes or ports")
+	}
+
+	return nil
+}
+
+// Delete a server
+// (DELETE /api/servers/{id})
+func (hi *httpImpl) DeleteServer(ctx context.Context, request openapi.DeleteServerRequestObject) (openapi.DeleteServerResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionDelete); err != nil {
+		return nil, err
+	}
+
+	// Purging removes bind mounted directories from the host, which is as much
+	// host access as mounting them in the first place.
+	purgeData := request.Params.PurgeData != nil && *request.Params.PurgeData
+	if purgeData {
+		if err := authorizeAdmin(ctx); err != nil {
+			return nil, errors.Wrap(err, "only admins may purge a server's data")
+		}
+	}
+
+	err := hi.usecases.DeleteServer(ctx, request.Id, purgeData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete server")
+	}
+
+	return openapi.DeleteServer204Response{}, nil
+}
+
+// Start a server
+// (POST /api/servers/{id}/start)
+func (hi *httpImpl) StartServer(ctx context.Context, request openapi.StartServerRequestObject) (openapi.StartServerResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionControl); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.StartServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start server")
+	}
+
+	oInst, err := openapi.ServerToOAPI(inst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode openapi server")
+	}
+
+	return openapi.StartServer200JSONResponse{Server: *oInst}, nil
+}
+
+// Gracefully stop a server
+// (POST /api/servers/{id}/stop)
+func (hi *httpImpl) StopServer(ctx context.Context, request openapi.StopServerRequestObject) (openapi.StopServerResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionControl); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.StopServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stop server")
+	}
+
+	oInst, err := openapi.ServerToOAPI(inst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode openapi server")
+	}
+
+	return openapi.StopServer200JSONResponse{Server: *oInst}, nil
+}
+
+// Forcefully kill a server
+// (POST /api/servers/{id}/kill)
+func (hi *httpImpl) KillServer(ctx context.Context, request openapi.KillServerRequestObject) (openapi.KillServerResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionControl); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.KillServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to kill server")
+	}
+
+	oInst, err := openapi.ServerToOAPI(inst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode openapi server")
+	}
+
+	return openapi.KillServer200JSONResponse{Server: *oInst}, nil
+}
+
+// Restart a server
+// (POST /api/servers/{id}/restart)
+func (hi *httpImpl) RestartServer(ctx context.Context, request openapi.RestartServerRequestObject) (openapi.RestartServerResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionControl); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.RestartServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to restart server")
+	}
+
+	oInst, err := openapi.ServerToOAPI(inst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode openapi server")
+	}
+
+	return openapi.RestartServer200JSONResponse{Server: *oInst}, nil
+}
@@ -1,14 +1,17 @@
 package http_test
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
 	"oppossome/serverpouch/internal/delivery/http/openapi"
 	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/domain/usecases"
 	"oppossome/serverpouch/internal/infrastructure/docker"
 
 	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+	mockUsecases "oppossome/serverpouch/internal/common/test/mocks/domain/usecases"
 
 	"github.com/Eun/go-hit"
 	"github.com/google/uuid"
@@ -24,6 +27,7 @@ func TestCreateServer(t *testing.T) {
 
 		// Create a test server configuration with minimal settings
 		cfg := docker.DockerServerInstanceOptions{
+			Meta:             server.ServerInstanceMetadata{Name: "test", Labels: map[string]string{}},
 			Image:            "test",
 			ContainerVolumes: map[string]string{},
 			ContainerPorts:   map[int]string{},
@@ -36,6 +40,8 @@ func TestCreateServer(t *testing.T) {
 		inst := mockServer.NewMockServerInstance(t)
 		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: cfg.Image})
 		inst.EXPECT().Status().Return(server.ServerInstanceStatusIdle)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
 
 		mockUsecases.EXPECT().CreateServer(sCtx, &cfg).Return(inst, nil)
 
@@ -47,11 +53,29 @@ func TestCreateServer(t *testing.T) {
 			hit.Post("%s/api/servers", testServer.URL),
 			hit.HTTPClient(testClient),
 			hit.Send().Headers("Content-Type").Add("application/json"),
-			hit.Send().Body().JSON(openapi.NewServer{Config: *oaCfg}),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
 			hit.Expect().Status().Equal(http.StatusCreated),
 			hitBodyJSONEquals(t, openapi.ServerResponse{Server: *oInst}),
 		)
 	})
+
+	t.Run("400 - Invalid body", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		oaCfg, err := openapi.ConfigToOAPI(&docker.DockerServerInstanceOptions{Image: "test", ContainerEnv: []string{}})
+		assert.NoError(t, err)
+
+		hit.MustDo(
+			hit.Post("%s/api/servers", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]any{"name": 5, "config": oaCfg}),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".type").Equal("urn:serverpouch:problem:validation"),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("/name"),
+		)
+	})
 }
 
 func TestGetServer(t *testing.T) {
@@ -61,8 +85,10 @@ func TestGetServer(t *testing.T) {
 
 		// Setup mock expectations
 		inst := mockServer.NewMockServerInstance(t)
-		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: "test"})
+		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: "test", Meta: server.ServerInstanceMetadata{Revision: 2}})
 		inst.EXPECT().Status().Return(server.ServerInstanceStatusIdle)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
 
 		mockUsecases.EXPECT().GetServer(mock.Anything, inst.Config().ID()).Return(inst, nil)
 
@@ -74,6 +100,7 @@ func TestGetServer(t *testing.T) {
 			hit.Get("%s/api/servers/%s", testServer.URL, inst.Config().ID()),
 			hit.HTTPClient(testClient),
 			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("ETag").Equal(`"2"`),
 			hitBodyJSONEquals(t, openapi.ServerResponse{Server: *oInst}),
 		)
 	})
@@ -83,12 +110,308 @@ func TestGetServer(t *testing.T) {
 		testClient := testServer.Client()
 
 		// Setup mock expectations
-		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(nil, errors.New("Not found!"))
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(nil, errors.WithStack(server.ErrInstanceNotFound))
+
+		problem := openapi.NewError(http.StatusNotFound, "not-found", "Server not found")
+		instance := fmt.Sprintf("/api/servers/%s", uuid.Nil)
+		problem.Instance = &instance
 
 		hit.MustDo(
 			hit.Get("%s/api/servers/%s", testServer.URL, uuid.Nil),
 			hit.HTTPClient(testClient),
 			hit.Expect().Status().Equal(http.StatusNotFound),
+			hit.Expect().Headers("Content-Type").Equal("application/problem+json"),
+			hitBodyJSONEquals(t, problem),
+		)
+	})
+
+	t.Run("500 - Internal Server Error", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(nil, errors.New("connection refused"))
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusInternalServerError),
+			hit.Expect().Body().JSON().JQ(".detail").Equal("An unexpected error occurred"),
+		)
+	})
+}
+
+func TestUpdateServer(t *testing.T) {
+	t.Run("200 - OK", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		cfg := docker.DockerServerInstanceOptions{
+			Meta:             server.ServerInstanceMetadata{Name: "test", Labels: map[string]string{}, Revision: 3},
+			Image:            "test",
+			ContainerVolumes: map[string]string{},
+			ContainerPorts:   map[int]string{25565: "25565/tcp"},
+			ContainerEnv:     []string{"EULA=true"},
+		}
+		oaCfg, err := openapi.ConfigToOAPI(&cfg)
+		assert.NoError(t, err)
+
+		// Setup mock expectations
+		id := uuid.New()
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: id, Image: cfg.Image, Meta: server.ServerInstanceMetadata{Revision: 4}})
+		inst.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
+
+		mockUsecases.EXPECT().UpdateServer(mock.Anything, id, &cfg).Return(inst, nil)
+
+		// Convert mock server instance to OpenAPI format for response validation
+		oInst, err := openapi.ServerToOAPI(inst)
+		assert.NoError(t, err)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`"3"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("ETag").Equal(`"4"`),
+			hitBodyJSONEquals(t, openapi.ServerResponse{Server: *oInst}),
+		)
+	})
+
+	t.Run("200 - Weak ETag", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		cfg := docker.DockerServerInstanceOptions{
+			Meta:             server.ServerInstanceMetadata{Name: "test", Labels: map[string]string{}, Revision: 3},
+			Image:            "test",
+			ContainerVolumes: map[string]string{},
+			ContainerPorts:   map[int]string{},
+			ContainerEnv:     []string{},
+		}
+		oaCfg, err := openapi.ConfigToOAPI(&cfg)
+		assert.NoError(t, err)
+
+		// Setup mock expectations
+		id := uuid.New()
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: id, Image: cfg.Image, Meta: server.ServerInstanceMetadata{Revision: 4}})
+		inst.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
+
+		mockUsecases.EXPECT().UpdateServer(mock.Anything, id, &cfg).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`W/"3"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("ETag").Equal(`"4"`),
+		)
+	})
+
+	t.Run("200 - Any revision", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		cfg := docker.DockerServerInstanceOptions{
+			Meta:             server.ServerInstanceMetadata{Name: "test", Labels: map[string]string{}, Revision: 7},
+			Image:            "test",
+			ContainerVolumes: map[string]string{},
+			ContainerPorts:   map[int]string{},
+			ContainerEnv:     []string{},
+		}
+		oaCfg, err := openapi.ConfigToOAPI(&cfg)
+		assert.NoError(t, err)
+
+		// Setup mock expectations
+		id := uuid.New()
+		current := mockServer.NewMockServerInstance(t)
+		current.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: id, Image: cfg.Image, Meta: server.ServerInstanceMetadata{Revision: 7}})
+		mockUsecases.EXPECT().GetServer(mock.Anything, id).Return(current, nil)
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: id, Image: cfg.Image, Meta: server.ServerInstanceMetadata{Revision: 8}})
+		inst.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
+
+		mockUsecases.EXPECT().UpdateServer(mock.Anything, id, &cfg).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add("*"),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("ETag").Equal(`"8"`),
+		)
+	})
+
+	t.Run("400 - Invalid Config", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		srvCfg := openapi.ServerConfig{}
+		err := srvCfg.FromServerConfigDocker(openapi.ServerConfigDocker{
+			Environment: []string{},
+			Image:       "test",
+			Ports:       []string{"invalid"},
+			Type:        openapi.ServerConfigDockerTypeDocker,
+			Volumes:     []string{},
+		})
+		assert.NoError(t, err)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`"0"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: srvCfg}),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".type").Equal("urn:serverpouch:problem:invalid-config"),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("/config/ports/0"),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		cfg := docker.DockerServerInstanceOptions{
+			Meta:             server.ServerInstanceMetadata{Name: "test", Labels: map[string]string{}},
+			Image:            "test",
+			ContainerVolumes: map[string]string{},
+			ContainerPorts:   map[int]string{},
+			ContainerEnv:     []string{},
+		}
+		oaCfg, err := openapi.ConfigToOAPI(&cfg)
+		assert.NoError(t, err)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().UpdateServer(mock.Anything, uuid.Nil, &cfg).Return(nil, errors.WithStack(server.ErrInstanceNotFound))
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`"0"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+	t.Run("412 - Precondition Failed", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		cfg := docker.DockerServerInstanceOptions{
+			Meta:             server.ServerInstanceMetadata{Name: "test", Labels: map[string]string{}, Revision: 1},
+			Image:            "test",
+			ContainerVolumes: map[string]string{},
+			ContainerPorts:   map[int]string{},
+			ContainerEnv:     []string{},
+		}
+		oaCfg, err := openapi.ConfigToOAPI(&cfg)
+		assert.NoError(t, err)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().UpdateServer(mock.Anything, uuid.Nil, &cfg).Return(nil, errors.WithStack(server.ErrRevisionMismatch))
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`"1"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusPreconditionFailed),
+			hit.Expect().Body().JSON().JQ(".type").Equal("urn:serverpouch:problem:precondition-failed"),
+		)
+	})
+
+	t.Run("412 - Unknown ETag", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		oaCfg, err := openapi.ConfigToOAPI(&docker.DockerServerInstanceOptions{Image: "test", ContainerEnv: []string{}})
+		assert.NoError(t, err)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Headers("If-Match").Add(`W/"abc"`),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusPreconditionFailed),
+		)
+	})
+
+	t.Run("428 - Precondition Required", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		oaCfg, err := openapi.ConfigToOAPI(&docker.DockerServerInstanceOptions{Image: "test", ContainerEnv: []string{}})
+		assert.NoError(t, err)
+
+		hit.MustDo(
+			hit.Put("%s/api/servers/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewServer{Name: "test", Config: *oaCfg}),
+			hit.Expect().Status().Equal(http.StatusPreconditionRequired),
+		)
+	})
+}
+
+func TestDeleteServer(t *testing.T) {
+	t.Run("204 - No Content", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		// Setup mock expectations
+		id := uuid.New()
+		mockUsecases.EXPECT().DeleteServer(mock.Anything, id, false).Return(nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/servers/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNoContent),
+		)
+	})
+
+	t.Run("204 - Purges Data", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		// Setup mock expectations
+		id := uuid.New()
+		mockUsecases.EXPECT().DeleteServer(mock.Anything, id, true).Return(nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/servers/%s?purgeData=true", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNoContent),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().DeleteServer(mock.Anything, uuid.Nil, false).Return(errors.WithStack(server.ErrInstanceNotFound))
+
+		hit.MustDo(
+			hit.Delete("%s/api/servers/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNotFound),
 		)
 	})
 }
@@ -102,8 +425,10 @@ func TestListServers(t *testing.T) {
 		inst := mockServer.NewMockServerInstance(t)
 		inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: "test"})
 		inst.EXPECT().Status().Return(server.ServerInstanceStatusIdle)
+		inst.EXPECT().StatusReason().Return("")
+		inst.EXPECT().Crashes().Return(server.CrashState{})
 
-		mockUsecases.EXPECT().ListServers(mock.Anything).Return([]server.ServerInstance{inst, inst})
+		mockUsecases.EXPECT().ListServers(mock.Anything, mock.Anything).Return([]server.ServerInstance{inst, inst}, "", nil)
 
 		// Convert mock server instance to OpenAPI format for response validation
 		oInst, err := openapi.ServerToOAPI(inst)
@@ -116,4 +441,145 @@ func TestListServers(t *testing.T) {
 			hitBodyJSONEquals(t, openapi.ServersResponse{Servers: []openapi.Server{*oInst, *oInst}}),
 		)
 	})
+
+	t.Run("200 - Filtered and paginated", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().ListServers(mock.Anything, mock.MatchedBy(func(opts usecases.ListServersOptions) bool {
+			return assert.Equal(t, []server.ServerInstanceStatus{server.ServerInstanceStatusIdle, server.ServerInstanceStatusRunning}, opts.Statuses) &&
+				assert.Equal(t, map[string]string{"game": "minecraft", "env": ""}, opts.Labels) &&
+				assert.Equal(t, usecases.ServerSortName, opts.Sort) &&
+				assert.True(t, opts.Descending) &&
+				assert.Equal(t, "previous", opts.Cursor) &&
+				assert.Equal(t, 10, opts.Limit)
+		})).Return([]server.ServerInstance{}, "next", nil)
+
+		nextCursor := "next"
+		hit.MustDo(
+			hit.Get("%s/api/servers?status=idle&status=running&label=game=minecraft&label=env&sort=name&order=desc&cursor=previous&limit=10", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hitBodyJSONEquals(t, openapi.ServersResponse{Servers: []openapi.Server{}, NextCursor: &nextCursor}),
+		)
+	})
+
+	t.Run("400 - Invalid cursor", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().ListServers(mock.Anything, mock.Anything).Return(nil, "", errors.WithStack(usecases.ErrInvalidCursor))
+
+		hit.MustDo(
+			hit.Get("%s/api/servers?cursor=invalid", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("cursor"),
+		)
+	})
+
+	t.Run("400 - Invalid limit", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Get("%s/api/servers?limit=0", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Headers("Content-Type").Equal("application/problem+json"),
+			hit.Expect().Body().JSON().JQ(".type").Equal("urn:serverpouch:problem:validation"),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("limit"),
+		)
+	})
+}
+
+func TestServerActions(t *testing.T) {
+	actions := []struct {
+		name   string
+		path   string
+		expect func(*mockUsecases.MockUsecases_Expecter, uuid.UUID) *mock.Call
+	}{
+		{
+			name: "Start",
+			path: "start",
+			expect: func(e *mockUsecases.MockUsecases_Expecter, id uuid.UUID) *mock.Call {
+				return e.StartServer(mock.Anything, id).Call
+			},
+		},
+		{
+			name: "Stop",
+			path: "stop",
+			expect: func(e *mockUsecases.MockUsecases_Expecter, id uuid.UUID) *mock.Call {
+				return e.StopServer(mock.Anything, id).Call
+			},
+		},
+		{
+			name: "Kill",
+			path: "kill",
+			expect: func(e *mockUsecases.MockUsecases_Expecter, id uuid.UUID) *mock.Call {
+				return e.KillServer(mock.Anything, id).Call
+			},
+		},
+		{
+			name: "Restart",
+			path: "restart",
+			expect: func(e *mockUsecases.MockUsecases_Expecter, id uuid.UUID) *mock.Call {
+				return e.RestartServer(mock.Anything, id).Call
+			},
+		},
+	}
+
+	for _, action := range actions {
+		t.Run(action.name+" 200 - OK", func(t *testing.T) {
+			_, mockUsecases, testServer := NewTestServer(t)
+			testClient := testServer.Client()
+
+			// Setup mock expectations
+			inst := mockServer.NewMockServerInstance(t)
+			inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: "test"})
+			inst.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+			inst.EXPECT().StatusReason().Return("")
+			inst.EXPECT().Crashes().Return(server.CrashState{})
+
+			action.expect(mockUsecases.EXPECT(), inst.Config().ID()).Return(inst, nil)
+
+			// Convert mock server instance to OpenAPI format for response validation
+			oInst, err := openapi.ServerToOAPI(inst)
+			assert.NoError(t, err)
+
+			hit.MustDo(
+				hit.Post("%s/api/servers/%s/%s", testServer.URL, inst.Config().ID(), action.path),
+				hit.HTTPClient(testClient),
+				hit.Expect().Status().Equal(http.StatusOK),
+				hitBodyJSONEquals(t, openapi.ServerResponse{Server: *oInst}),
+			)
+		})
+
+		t.Run(action.name+" 404 - Not Found", func(t *testing.T) {
+			_, mockUsecases, testServer := NewTestServer(t)
+			testClient := testServer.Client()
+
+			action.expect(mockUsecases.EXPECT(), uuid.Nil).Return(nil, errors.WithStack(server.ErrInstanceNotFound))
+
+			hit.MustDo(
+				hit.Post("%s/api/servers/%s/%s", testServer.URL, uuid.Nil, action.path),
+				hit.HTTPClient(testClient),
+				hit.Expect().Status().Equal(http.StatusNotFound),
+			)
+		})
+
+		t.Run(action.name+" 409 - Conflict", func(t *testing.T) {
+			_, mockUsecases, testServer := NewTestServer(t)
+			testClient := testServer.Client()
+
+			statusErr := &server.InvalidStatusError{Action: action.name, Status: server.ServerInstanceStatusInitializing}
+			action.expect(mockUsecases.EXPECT(), uuid.Nil).Return(nil, errors.Wrap(statusErr, "failed"))
+
+			hit.MustDo(
+				hit.Post("%s/api/servers/%s/%s", testServer.URL, uuid.Nil, action.path),
+				hit.HTTPClient(testClient),
+				hit.Expect().Status().Equal(http.StatusConflict),
+			)
+		})
+	}
 }
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// statsHistoryDefault is how far back the history goes without a since.
+const statsHistoryDefault = 24 * time.Hour
+
+// Read a server's resource usage
+// (GET /api/servers/{id}/stats)
+func (hi *httpImpl) GetServerStats(ctx context.Context, request openapi.GetServerStatsRequestObject) (openapi.GetServerStatsResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionView); err != nil {
+		return nil, err
+	}
+
+	inst, err := hi.usecases.GetServer(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server")
+	}
+
+	follow := request.Params.Follow != nil && *request.Params.Follow
+	reader, err := inst.Stats(ctx, follow)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read server stats")
+	}
+
+	if follow {
+		return serverStatsResponse{ctx: ctx, reader: reader}, nil
+	}
+	defer reader.Close()
+
+	stats, err := reader.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read server stats")
+	}
+
+	return openapi.GetServerStats200JSONResponse{Stats: openapi.StatsToOAPI(stats)}, nil
+}
+
+// Read a server's recorded resource usage
+// (GET /api/servers/{id}/stats/history)
+func (hi *httpImpl) GetServerStatsHistory(ctx context.Context, request openapi.GetServerStatsHistoryRequestObject) (openapi.GetServerStatsHistoryResponseObject, error) {
+	if err := authorize(ctx, request.Id, auth.PermissionView); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	since := now.Add(-statsHistoryDefault)
+	until := now
+
+	var err error
+	if request.Params.Since != nil {
+		if since, err = parseTimeParam(now, *request.Params.Since); err != nil {
+			return nil, &invalidParamError{Param: "since", Reason: err.Error()}
+		}
+	}
+	if request.Params.Until != nil {
+		if until, err = parseTimeParam(now, *request.Params.Until); err != nil {
+			return nil, &invalidParamError{Param: "until", Reason: err.Error()}
+		}
+	}
+
+	history, err := hi.usecases.GetServerStatsHistory(ctx, request.Id, since, until)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server stats history")
+	}
+
+	samples := make([]openapi.ServerStats, 0, len(history.Samples))
+	for _, stats := range history.Samples {
+		samples = append(samples, openapi.StatsToOAPI(stats))
+	}
+
+	return openapi.GetServerStatsHistory200JSONResponse{
+		Resolution: int(history.Resolution / time.Second),
+		Samples:    samples,
+	}, nil
+}
+
+// serverStatsResponse streams each sample as NDJSON as soon as it's taken.
+type serverStatsResponse struct {
+	ctx    context.Context
+	reader server.StatsReader
+}
+
+func (ssr serverStatsResponse) VisitGetServerStatsResponse(w http.ResponseWriter) error {
+	defer ssr.reader.Close()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	for {
+		stats, err := ssr.reader.Next()
+		switch {
+		case err == io.EOF, ssr.ctx.Err() != nil:
+			return nil
+		case err != nil:
+			// The response has already begun, so all we can do is end it early.
+			zerolog.Ctx(ssr.ctx).Err(err).Msg("failed to read server stats")
+			return nil
+		}
+
+		if err := encoder.Encode(openapi.StatsToOAPI(stats)); err != nil {
+			return nil
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
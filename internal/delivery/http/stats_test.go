@@ -0,0 +1,145 @@
+package http_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/domain/usecases"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+// testStatsReader reads back a fixed set of samples.
+type testStatsReader struct {
+	samples []server.Stats
+}
+
+func (tsr *testStatsReader) Next() (server.Stats, error) {
+	if len(tsr.samples) == 0 {
+		return server.Stats{}, io.EOF
+	}
+
+	stats := tsr.samples[0]
+	tsr.samples = tsr.samples[1:]
+	return stats, nil
+}
+
+func (tsr *testStatsReader) Close() error {
+	return nil
+}
+
+var testStats = []server.Stats{
+	{Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), CPUPercent: 12.5, MemoryUsage: 1024, MemoryLimit: 4096, PIDs: 3},
+	{Time: time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC), CPUPercent: 50, MemoryUsage: 2048, MemoryLimit: 4096, PIDs: 4},
+}
+
+func TestGetServerStats(t *testing.T) {
+	t.Run("200 - JSON", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Stats(mock.Anything, false).Return(&testStatsReader{samples: testStats[:1]}, nil)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/stats", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".stats.cpuPercent").Equal(12.5),
+			hit.Expect().Body().JSON().JQ(".stats.memoryUsage").Equal(1024),
+			hit.Expect().Body().JSON().JQ(".stats.pids").Equal(3),
+		)
+	})
+
+	t.Run("200 - Follow", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Stats(mock.Anything, true).Return(&testStatsReader{samples: testStats}, nil)
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/stats?follow=true", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Headers("Content-Type").Equal("application/x-ndjson"),
+			hit.Expect().Body().String().Contains("\"cpuPercent\":12.5"),
+			hit.Expect().Body().String().Contains("\"cpuPercent\":50"),
+		)
+	})
+
+	t.Run("409 - Not running", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Stats(mock.Anything, false).Return(nil, &server.InvalidStatusError{
+			Action: "Stats",
+			Status: server.ServerInstanceStatusIdle,
+		})
+
+		mockUsecases.EXPECT().GetServer(mock.Anything, uuid.Nil).Return(inst, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/stats", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusConflict),
+		)
+	})
+}
+
+func TestGetServerStatsHistory(t *testing.T) {
+	t.Run("200 - Samples", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().GetServerStatsHistory(mock.Anything, uuid.Nil, mock.MatchedBy(func(since time.Time) bool {
+			return time.Since(since) > 59*time.Minute && time.Since(since) < 61*time.Minute
+		}), mock.Anything).Return(&usecases.StatsHistory{Resolution: time.Minute, Samples: testStats}, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/stats/history?since=1h", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hit.Expect().Body().JSON().JQ(".resolution").Equal(60),
+			hit.Expect().Body().JSON().JQ(".samples | map(.pids)").Equal([]int{3, 4}),
+		)
+	})
+
+	t.Run("400 - Invalid since", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/stats/history?since=yesterday", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+			hit.Expect().Body().JSON().JQ(".errors[0].field").Equal("since"),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		// Setup mock expectations
+		mockUsecases.EXPECT().GetServerStatsHistory(mock.Anything, uuid.Nil, mock.Anything, mock.Anything).
+			Return(nil, errors.WithStack(server.ErrInstanceNotFound))
+
+		hit.MustDo(
+			hit.Get("%s/api/servers/%s/stats/history", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testServer.Client()),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+}
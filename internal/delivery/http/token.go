@@ -0,0 +1,82 @@
+package http
+
+import (
+	"context"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+
+	"github.com/pkg/errors"
+)
+
+// List all API tokens
+// (GET /api/tokens)
+func (hi *httpImpl) ListAPITokens(ctx context.Context, request openapi.ListAPITokensRequestObject) (openapi.ListAPITokensResponseObject, error) {
+	user, _ := auth.UserFromContext(ctx)
+
+	var tokens []*auth.APIToken
+	var err error
+	if user.IsAdmin {
+		tokens, err = hi.usecases.ListAPITokens(ctx)
+	} else {
+		tokens, err = hi.usecases.ListUserAPITokens(ctx, user.ID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tokens")
+	}
+
+	oTokens := make([]openapi.APIToken, len(tokens))
+	for idx, token := range tokens {
+		oTokens[idx] = openapi.TokenToOAPI(token)
+	}
+
+	return openapi.ListAPITokens200JSONResponse{Tokens: oTokens}, nil
+}
+
+// Create a new API token
+// (POST /api/tokens)
+func (hi *httpImpl) CreateAPIToken(ctx context.Context, request openapi.CreateAPITokenRequestObject) (openapi.CreateAPITokenResponseObject, error) {
+	user, _ := auth.UserFromContext(ctx)
+
+	userID := user.ID
+	if request.Body.UserId != nil && *request.Body.UserId != user.ID {
+		if err := authorizeAdmin(ctx); err != nil {
+			return nil, err
+		}
+
+		userID = *request.Body.UserId
+	}
+
+	token, secret, err := hi.usecases.CreateAPIToken(ctx, userID, request.Body.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create token")
+	}
+
+	return openapi.CreateAPIToken201JSONResponse{
+		Token:  openapi.TokenToOAPI(token),
+		Secret: secret,
+	}, nil
+}
+
+// Revoke an API token
+// (DELETE /api/tokens/{id})
+func (hi *httpImpl) RevokeAPIToken(ctx context.Context, request openapi.RevokeAPITokenRequestObject) (openapi.RevokeAPITokenResponseObject, error) {
+	user, _ := auth.UserFromContext(ctx)
+
+	token, err := hi.usecases.GetAPIToken(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get token")
+	}
+
+	// Other users' tokens are hidden rather than forbidden.
+	if token.UserID != user.ID && !user.IsAdmin {
+		return nil, errors.Wrapf(auth.ErrTokenNotFound, "token of ID \"%s\"", request.Id)
+	}
+
+	_, err = hi.usecases.RevokeAPIToken(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke token")
+	}
+
+	return openapi.RevokeAPIToken204Response{}, nil
+}
@@ -0,0 +1,197 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthentication(t *testing.T) {
+	t.Run("401 - Missing token", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.Expect().Status().Equal(http.StatusUnauthorized),
+			hit.Expect().Headers("WWW-Authenticate").Equal("Bearer"),
+			hit.Expect().Body().JSON().JQ(".type").Equal("urn:serverpouch:problem:unauthorized"),
+		)
+	})
+
+	t.Run("401 - Invalid token", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.Send().Headers("Authorization").Add("Bearer sp_invalid"),
+			hit.Expect().Status().Equal(http.StatusUnauthorized),
+			hit.Expect().Headers("WWW-Authenticate").Equal("Bearer"),
+		)
+	})
+
+	t.Run("401 - Malformed header", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.Send().Headers("Authorization").Add("Basic dGVzdDp0ZXN0"),
+			hit.Expect().Status().Equal(http.StatusUnauthorized),
+		)
+	})
+
+	t.Run("200 - Valid token", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+
+		mockUsecases.EXPECT().ListServers(mock.Anything, mock.Anything).Return([]server.ServerInstance{}, "", nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/servers", testServer.URL),
+			hit.Send().Headers("Authorization").Add("Bearer "+testToken),
+			hit.Expect().Status().Equal(http.StatusOK),
+		)
+	})
+}
+
+func TestListAPITokens(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		revokedAt := time.Now().UTC().Truncate(time.Second)
+		tokens := []*auth.APIToken{
+			{ID: uuid.New(), Name: "ci", CreatedAt: revokedAt.Add(-time.Hour)},
+			{ID: uuid.New(), Name: "old", CreatedAt: revokedAt.Add(-2 * time.Hour), RevokedAt: &revokedAt},
+		}
+
+		mockUsecases.EXPECT().ListAPITokens(mock.Anything).Return(tokens, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/tokens", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hitBodyJSONEquals(t, openapi.APITokensResponse{Tokens: []openapi.APIToken{
+				openapi.TokenToOAPI(tokens[0]),
+				openapi.TokenToOAPI(tokens[1]),
+			}}),
+		)
+	})
+
+	t.Run("200 - Only own tokens", func(t *testing.T) {
+		user := &auth.User{ID: uuid.New(), Name: "user"}
+		_, mockUsecases, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		tokens := []*auth.APIToken{{ID: uuid.New(), UserID: user.ID, Name: "ci"}}
+		mockUsecases.EXPECT().ListUserAPITokens(mock.Anything, user.ID).Return(tokens, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/tokens", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hitBodyJSONEquals(t, openapi.APITokensResponse{Tokens: []openapi.APIToken{openapi.TokenToOAPI(tokens[0])}}),
+		)
+	})
+}
+
+func TestCreateAPIToken(t *testing.T) {
+	t.Run("201 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		token := &auth.APIToken{ID: uuid.New(), Name: "ci", CreatedAt: time.Now().UTC().Truncate(time.Second)}
+		mockUsecases.EXPECT().CreateAPIToken(mock.Anything, uuid.Nil, "ci").Return(token, "sp_secret", nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/tokens", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewAPIToken{Name: "ci"}),
+			hit.Expect().Status().Equal(http.StatusCreated),
+			hitBodyJSONEquals(t, openapi.NewAPITokenResponse{
+				Token:  openapi.TokenToOAPI(token),
+				Secret: "sp_secret",
+			}),
+		)
+	})
+
+	t.Run("403 - Token for another user", func(t *testing.T) {
+		_, _, testServer := NewTestServerAs(t, &auth.User{ID: uuid.New(), Name: "user"})
+		testClient := testServer.Client()
+
+		userID := uuid.New()
+
+		hit.MustDo(
+			hit.Post("%s/api/tokens", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewAPIToken{Name: "ci", UserId: &userID}),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+
+	t.Run("400 - Empty name", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Post("%s/api/tokens", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewAPIToken{Name: ""}),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+		)
+	})
+}
+
+func TestRevokeAPIToken(t *testing.T) {
+	t.Run("204 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		token := &auth.APIToken{ID: uuid.New(), UserID: uuid.New(), Name: "ci"}
+		mockUsecases.EXPECT().GetAPIToken(mock.Anything, token.ID).Return(token, nil)
+		mockUsecases.EXPECT().RevokeAPIToken(mock.Anything, token.ID).Return(token, nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/tokens/%s", testServer.URL, token.ID),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNoContent),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().GetAPIToken(mock.Anything, uuid.Nil).Return(nil, errors.WithStack(auth.ErrTokenNotFound))
+
+		hit.MustDo(
+			hit.Delete("%s/api/tokens/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+
+	t.Run("404 - Another user's token", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServerAs(t, &auth.User{ID: uuid.New(), Name: "user"})
+		testClient := testServer.Client()
+
+		token := &auth.APIToken{ID: uuid.New(), UserID: uuid.New(), Name: "ci"}
+		mockUsecases.EXPECT().GetAPIToken(mock.Anything, token.ID).Return(token, nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/tokens/%s", testServer.URL, token.ID),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+}
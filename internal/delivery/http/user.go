@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+
+	"github.com/pkg/errors"
+)
+
+// List all users
+// (GET /api/users)
+func (hi *httpImpl) ListUsers(ctx context.Context, request openapi.ListUsersRequestObject) (openapi.ListUsersResponseObject, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := hi.usecases.ListUsers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+
+	oUsers := make([]openapi.User, len(users))
+	for idx, user := range users {
+		oUsers[idx] = openapi.UserToOAPI(user)
+	}
+
+	return openapi.ListUsers200JSONResponse{Users: oUsers}, nil
+}
+
+// Create a new user
+// (POST /api/users)
+func (hi *httpImpl) CreateUser(ctx context.Context, request openapi.CreateUserRequestObject) (openapi.CreateUserResponseObject, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	isAdmin := request.Body.IsAdmin != nil && *request.Body.IsAdmin
+
+	user, err := hi.usecases.CreateUser(ctx, request.Body.Name, isAdmin)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+
+	return openapi.CreateUser201JSONResponse{User: openapi.UserToOAPI(user)}, nil
+}
+
+// Get the user the request is authenticated as
+// (GET /api/users/me)
+func (hi *httpImpl) GetCurrentUser(ctx context.Context, request openapi.GetCurrentUserRequestObject) (openapi.GetCurrentUserResponseObject, error) {
+	user, _ := auth.UserFromContext(ctx)
+	return openapi.GetCurrentUser200JSONResponse{User: openapi.UserToOAPI(user)}, nil
+}
+
+// Delete a user along with their tokens and grants
+// (DELETE /api/users/{id})
+func (hi *httpImpl) DeleteUser(ctx context.Context, request openapi.DeleteUserRequestObject) (openapi.DeleteUserResponseObject, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	err := hi.usecases.DeleteUser(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete user")
+	}
+
+	return openapi.DeleteUser204Response{}, nil
+}
+
+// Grant a user a role on a server
+// (PUT /api/users/{id}/grants/{serverId})
+func (hi *httpImpl) SetServerGrant(ctx context.Context, request openapi.SetServerGrantRequestObject) (openapi.SetServerGrantResponseObject, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := hi.usecases.SetServerGrant(ctx, request.Id, request.ServerId, auth.Role(request.Body.Role))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to grant role")
+	}
+
+	return openapi.SetServerGrant200JSONResponse{User: openapi.UserToOAPI(user)}, nil
+}
+
+// Revoke a user's role on a server
+// (DELETE /api/users/{id}/grants/{serverId})
+func (hi *httpImpl) DeleteServerGrant(ctx context.Context, request openapi.DeleteServerGrantRequestObject) (openapi.DeleteServerGrantResponseObject, error) {
+	if err := authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := hi.usecases.DeleteServerGrant(ctx, request.Id, request.ServerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke role")
+	}
+
+	return openapi.DeleteServerGrant200JSONResponse{User: openapi.UserToOAPI(user)}, nil
+}
@@ -0,0 +1,175 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/delivery/http/openapi"
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/Eun/go-hit"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListUsers(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		users := []*auth.User{{
+			ID:        uuid.New(),
+			Name:      "moderator",
+			CreatedAt: time.Now().UTC().Truncate(time.Second),
+			Grants:    map[uuid.UUID]auth.Role{uuid.New(): auth.RoleModerator},
+		}}
+		mockUsecases.EXPECT().ListUsers(mock.Anything).Return(users, nil)
+
+		hit.MustDo(
+			hit.Get("%s/api/users", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hitBodyJSONEquals(t, openapi.UsersResponse{Users: []openapi.User{openapi.UserToOAPI(users[0])}}),
+		)
+	})
+
+	t.Run("403 - Not an admin", func(t *testing.T) {
+		_, _, testServer := NewTestServerAs(t, &auth.User{ID: uuid.New(), Name: "user"})
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Get("%s/api/users", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusForbidden),
+		)
+	})
+}
+
+func TestGetCurrentUser(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		user := &auth.User{ID: uuid.New(), Name: "user", Grants: map[uuid.UUID]auth.Role{}}
+		_, _, testServer := NewTestServerAs(t, user)
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Get("%s/api/users/me", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hitBodyJSONEquals(t, openapi.UserResponse{User: openapi.UserToOAPI(user)}),
+		)
+	})
+}
+
+func TestCreateUser(t *testing.T) {
+	t.Run("201 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		user := &auth.User{ID: uuid.New(), Name: "user", Grants: map[uuid.UUID]auth.Role{}}
+		mockUsecases.EXPECT().CreateUser(mock.Anything, "user", false).Return(user, nil)
+
+		hit.MustDo(
+			hit.Post("%s/api/users", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewUser{Name: "user"}),
+			hit.Expect().Status().Equal(http.StatusCreated),
+			hitBodyJSONEquals(t, openapi.UserResponse{User: openapi.UserToOAPI(user)}),
+		)
+	})
+
+	t.Run("409 - Conflict", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().CreateUser(mock.Anything, "user", false).Return(nil, errors.WithStack(auth.ErrUserExists))
+
+		hit.MustDo(
+			hit.Post("%s/api/users", testServer.URL),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewUser{Name: "user"}),
+			hit.Expect().Status().Equal(http.StatusConflict),
+		)
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	t.Run("204 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		id := uuid.New()
+		mockUsecases.EXPECT().DeleteUser(mock.Anything, id).Return(nil)
+
+		hit.MustDo(
+			hit.Delete("%s/api/users/%s", testServer.URL, id),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNoContent),
+		)
+	})
+
+	t.Run("404 - Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().DeleteUser(mock.Anything, uuid.Nil).Return(errors.WithStack(auth.ErrUserNotFound))
+
+		hit.MustDo(
+			hit.Delete("%s/api/users/%s", testServer.URL, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+}
+
+func TestSetServerGrant(t *testing.T) {
+	t.Run("200 - Ok", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		serverID := uuid.New()
+		user := &auth.User{ID: uuid.New(), Name: "user", Grants: map[uuid.UUID]auth.Role{serverID: auth.RoleModerator}}
+		mockUsecases.EXPECT().SetServerGrant(mock.Anything, user.ID, serverID, auth.RoleModerator).Return(user, nil)
+
+		hit.MustDo(
+			hit.Put("%s/api/users/%s/grants/%s", testServer.URL, user.ID, serverID),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewServerGrant{Role: openapi.Moderator}),
+			hit.Expect().Status().Equal(http.StatusOK),
+			hitBodyJSONEquals(t, openapi.UserResponse{User: openapi.UserToOAPI(user)}),
+		)
+	})
+
+	t.Run("400 - Unknown role", func(t *testing.T) {
+		_, _, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		hit.MustDo(
+			hit.Put("%s/api/users/%s/grants/%s", testServer.URL, uuid.New(), uuid.New()),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(map[string]string{"role": "owner"}),
+			hit.Expect().Status().Equal(http.StatusBadRequest),
+		)
+	})
+
+	t.Run("404 - Server Not Found", func(t *testing.T) {
+		_, mockUsecases, testServer := NewTestServer(t)
+		testClient := testServer.Client()
+
+		mockUsecases.EXPECT().SetServerGrant(mock.Anything, uuid.Nil, uuid.Nil, auth.RoleViewer).Return(nil, errors.WithStack(server.ErrInstanceNotFound))
+
+		hit.MustDo(
+			hit.Put("%s/api/users/%s/grants/%s", testServer.URL, uuid.Nil, uuid.Nil),
+			hit.HTTPClient(testClient),
+			hit.Send().Headers("Content-Type").Add("application/json"),
+			hit.Send().Body().JSON(openapi.NewServerGrant{Role: openapi.Viewer}),
+			hit.Expect().Status().Equal(http.StatusNotFound),
+		)
+	})
+}
@@ -0,0 +1,15 @@
+package auth
+
+import "context"
+
+var userKey = &struct{ name string }{"user"}
+
+func WithUser(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userKey, user)
+}
+
+// UserFromContext returns the user the request was authenticated as, if any.
+func UserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(userKey).(*User)
+	return user, ok
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrForbidden is returned when a user lacks the permission for an action.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrUserNotFound is returned when a user can't be found.
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrUserExists is returned when a user's name is already taken.
+	ErrUserExists = errors.New("user already exists")
+
+	// ErrInvalidRole is returned when a role isn't one of the known roles.
+	ErrInvalidRole = errors.New("invalid role")
+)
+
+// Permission is an action a user may perform on a server.
+type Permission string
+
+const (
+	PermissionView      Permission = "view"
+	PermissionConsole   Permission = "console"
+	PermissionControl   Permission = "control"
+	PermissionConfigure Permission = "configure"
+	PermissionDelete    Permission = "delete"
+)
+
+// Role is a named set of permissions granted to a user on a single server.
+type Role string
+
+const (
+	RoleViewer    Role = "viewer"
+	RoleModerator Role = "moderator"
+	RoleManager   Role = "manager"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleViewer:    {PermissionView},
+	RoleModerator: {PermissionView, PermissionConsole, PermissionControl},
+	// Managers may configure and delete a server, but not change its image,
+	// volumes or ports, nor purge its data, since bind mounts would give them
+	// the host.
+	RoleManager: {PermissionView, PermissionConsole, PermissionControl, PermissionConfigure, PermissionDelete},
+}
+
+// Permissions returns the permissions granted by the role.
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+func (r Role) Valid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+type User struct {
+	ID        uuid.UUID
+	Name      string
+	IsAdmin   bool
+	CreatedAt time.Time
+
+	// Grants maps server IDs to the role the user holds on them. Admins have
+	// every permission on every server regardless.
+	Grants map[uuid.UUID]Role
+}
+
+// Can reports whether the user holds the permission on the given server.
+func (u *User) Can(serverID uuid.UUID, permission Permission) bool {
+	if u.IsAdmin {
+		return true
+	}
+
+	role, ok := u.Grants[serverID]
+	return ok && slices.Contains(role.Permissions(), permission)
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"oppossome/serverpouch/internal/common/events"
+)
+
+// ConsoleHistorySize is how many lines of console output each instance keeps.
+const ConsoleHistorySize = 1000
+
+// ConsoleLine is a line of console output, numbered in the order it was
+// written starting from 1.
+type ConsoleLine struct {
+	Seq  uint64
+	Time time.Time
+	Text string
+}
+
+// ConsoleHistory keeps the most recent lines of console output, so clients
+// connecting later can catch up on what they missed.
+type ConsoleHistory struct {
+	mu    sync.RWMutex
+	lines []ConsoleLine
+	// start is the index of the oldest line once the buffer has filled up.
+	start int
+	seq   uint64
+}
+
+func NewConsoleHistory(size int) *ConsoleHistory {
+	return &ConsoleHistory{
+		lines: make([]ConsoleLine, 0, size),
+	}
+}
+
+// Append records a line, overwriting the oldest once the history is full.
+func (ch *ConsoleHistory) Append(text string) ConsoleLine {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.seq++
+	line := ConsoleLine{Seq: ch.seq, Time: time.Now(), Text: text}
+
+	if len(ch.lines) < cap(ch.lines) {
+		ch.lines = append(ch.lines, line)
+	} else {
+		ch.lines[ch.start] = line
+		ch.start = (ch.start + 1) % len(ch.lines)
+	}
+
+	return line
+}
+
+// Since returns the lines after seq, oldest first. Truncated reports whether
+// any of them have already been overwritten. Numbering restarts along with the
+// daemon, so a seq from the future gets every line, reported as truncated.
+func (ch *ConsoleHistory) Since(seq uint64) (lines []ConsoleLine, truncated bool) {
+	ch.mu.RLock()
+	defer ch.mu.RUnlock()
+
+	if seq > ch.seq {
+		seq = 0
+		truncated = true
+	}
+
+	lines = []ConsoleLine{}
+	for idx := range ch.lines {
+		line := ch.lines[(ch.start+idx)%len(ch.lines)]
+		if line.Seq > seq {
+			lines = append(lines, line)
+		}
+	}
+
+	oldest := ch.seq - uint64(len(ch.lines)) + 1
+	return lines, truncated || seq+1 < oldest
+}
+
+// record appends every line dispatched to the emitter until it's closed.
+func (ch *ConsoleHistory) record(emitter events.EventEmitter[string]) {
+	lines := emitter.On()
+
+	go func() {
+		for line := range lines {
+			ch.Append(line)
+		}
+	}()
+}
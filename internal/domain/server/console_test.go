@@ -0,0 +1,65 @@
+package server_test
+
+import (
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func consoleTexts(lines []server.ConsoleLine) []string {
+	texts := []string{}
+	for _, line := range lines {
+		texts = append(texts, line.Text)
+	}
+
+	return texts
+}
+
+func TestConsoleHistory(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		history := server.NewConsoleHistory(3)
+		history.Append("a")
+		history.Append("b")
+
+		lines, truncated := history.Since(0)
+		assert.Equal(t, []string{"a", "b"}, consoleTexts(lines))
+		assert.Equal(t, uint64(1), lines[0].Seq)
+		assert.False(t, truncated)
+
+		lines, truncated = history.Since(2)
+		assert.Empty(t, lines)
+		assert.False(t, truncated)
+	})
+
+	t.Run("Ok - Overwrites the oldest lines", func(t *testing.T) {
+		history := server.NewConsoleHistory(3)
+		for _, text := range []string{"a", "b", "c", "d", "e"} {
+			history.Append(text)
+		}
+
+		lines, truncated := history.Since(0)
+		assert.Equal(t, []string{"c", "d", "e"}, consoleTexts(lines))
+		assert.Equal(t, uint64(3), lines[0].Seq)
+		assert.True(t, truncated)
+
+		// Nothing after line 2 was lost.
+		lines, truncated = history.Since(2)
+		assert.Equal(t, []string{"c", "d", "e"}, consoleTexts(lines))
+		assert.False(t, truncated)
+
+		lines, truncated = history.Since(4)
+		assert.Equal(t, []string{"e"}, consoleTexts(lines))
+		assert.False(t, truncated)
+	})
+
+	t.Run("Ok - Sequence from before a restart", func(t *testing.T) {
+		history := server.NewConsoleHistory(3)
+		history.Append("a")
+
+		lines, truncated := history.Since(100)
+		assert.Equal(t, []string{"a"}, consoleTexts(lines))
+		assert.True(t, truncated)
+	})
+}
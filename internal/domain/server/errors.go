@@ -0,0 +1,36 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInstanceNotFound is returned when a server instance can't be found.
+var ErrInstanceNotFound = errors.New("server instance not found")
+
+// ErrRevisionMismatch is returned when updating a server that has been updated
+// since the revision the update was made against.
+var ErrRevisionMismatch = errors.New("server instance has been modified")
+
+// InvalidStatusError is returned when an action is attempted on an instance
+// whose status doesn't permit it.
+type InvalidStatusError struct {
+	Action string
+	Status ServerInstanceStatus
+}
+
+func (e *InvalidStatusError) Error() string {
+	return fmt.Sprintf("%s is an invalid action for status %s", e.Action, e.Status)
+}
+
+// InvalidConfigError is returned when a server's configuration is invalid.
+// Field is a JSON pointer to the offending value.
+type InvalidConfigError struct {
+	Field  string
+	Reason string
+}
+
+func (e *InvalidConfigError) Error() string {
+	return fmt.Sprintf("invalid config at %s: %s", e.Field, e.Reason)
+}
@@ -0,0 +1,23 @@
+package server
+
+import "context"
+
+// ExecOptions describes a command to run within a server, alongside its main
+// process.
+type ExecOptions struct {
+	// Cmd is the command followed by its arguments.
+	Cmd []string
+	// Env is added to the server's environment, as KEY=value.
+	Env        []string
+	WorkingDir string
+	User       string
+}
+
+// ExecProcess is a command running within a server. Its output is read a line
+// at a time like the server's logs, though without timestamps.
+type ExecProcess interface {
+	LogReader
+	// Wait waits for the command to exit and returns its exit code. It's meant
+	// to be called once all of the output has been read.
+	Wait(ctx context.Context) (int, error)
+}
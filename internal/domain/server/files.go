@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrFileNotFound is returned when a file doesn't exist within the server's volumes.
+var ErrFileNotFound = errors.New("file not found")
+
+// ErrFileExists is returned when creating or renaming onto an existing file.
+var ErrFileExists = errors.New("file already exists")
+
+// ErrDirectoryNotEmpty is returned when removing a directory with files in it,
+// without removing them too.
+var ErrDirectoryNotEmpty = errors.New("directory not empty")
+
+// InvalidPathError is returned when a path can't be used, such as when it's
+// not within any of the server's volumes or isn't the kind of file expected.
+type InvalidPathError struct {
+	Path   string
+	Reason string
+}
+
+func (e *InvalidPathError) Error() string {
+	return fmt.Sprintf("invalid path %s: %s", e.Path, e.Reason)
+}
+
+type FileType string
+
+const (
+	FileTypeFile      FileType = "file"
+	FileTypeDirectory FileType = "directory"
+	FileTypeSymlink   FileType = "symlink"
+)
+
+// FileInfo describes a file within a server's volumes.
+type FileInfo struct {
+	// Path is where the file is found within the container.
+	Path    string
+	Type    FileType
+	Size    int64
+	ModTime time.Time
+}
+
+// Files manages the files within a server's volumes. Paths are those seen from
+// within the container, and may not leave the volume they start in.
+type Files interface {
+	// List lists a directory, or every volume given "".
+	List(ctx context.Context, path string) ([]FileInfo, error)
+	Open(ctx context.Context, path string) (io.ReadCloser, FileInfo, error)
+	// Write creates or replaces a file with the reader's contents.
+	Write(ctx context.Context, path string, contents io.Reader) (FileInfo, error)
+	Mkdir(ctx context.Context, path string) (FileInfo, error)
+	Rename(ctx context.Context, from string, to string) (FileInfo, error)
+	// Remove removes a file or an empty directory, or with recursive a
+	// directory along with everything in it.
+	Remove(ctx context.Context, path string, recursive bool) error
+}
@@ -0,0 +1,15 @@
+package server
+
+// ImageUpdate compares the image a server's container was created from with
+// the one its tag currently resolves to.
+type ImageUpdate struct {
+	Image string
+	// CurrentDigest is empty if the container's image was never pulled from a
+	// registry, such as one built locally.
+	CurrentDigest string
+	LatestDigest  string
+	// Available is whether the latest image differs from the container's.
+	Available bool
+	// Applied is whether the container was recreated from the latest image.
+	Applied bool
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"oppossome/serverpouch/internal/common/events"
 
@@ -17,6 +18,9 @@ const (
 	ServerInstanceStatusRunning      ServerInstanceStatus = "running"
 	ServerInstanceStatusStopping     ServerInstanceStatus = "stopping"
 	ServerInstanceStatusErrored      ServerInstanceStatus = "errored"
+	// ServerInstanceStatusCrashlooping is a server waiting to be restarted
+	// after exiting on its own.
+	ServerInstanceStatusCrashlooping ServerInstanceStatus = "crashlooping"
 )
 
 type ServerInstanceType string
@@ -29,23 +33,58 @@ type ServerInstanceEvents struct {
 	Status      events.EventEmitter[ServerInstanceStatus]
 	TerminalOut events.EventEmitter[string]
 	TerminalIn  events.EventEmitter[string]
+
+	// ConsoleHistory records everything dispatched to TerminalOut, whether or
+	// not anyone was listening at the time.
+	ConsoleHistory *ConsoleHistory
 }
 
 func NewServerInstanceEvents() *ServerInstanceEvents {
-	return &ServerInstanceEvents{
-		Status:      events.New[ServerInstanceStatus](),
-		TerminalOut: events.New[string](),
-		TerminalIn:  events.New[string](),
+	sie := &ServerInstanceEvents{
+		Status:      events.New[ServerInstanceStatus]("status"),
+		TerminalOut: events.New[string]("terminal_out"),
+		TerminalIn:  events.New[string]("terminal_in"),
+
+		ConsoleHistory: NewConsoleHistory(ConsoleHistorySize),
 	}
+
+	sie.ConsoleHistory.record(sie.TerminalOut)
+
+	return sie
+}
+
+// Close closes every emitter, and in turn every listener.
+func (sie *ServerInstanceEvents) Close() {
+	sie.Status.Close()
+	sie.TerminalOut.Close()
+	sie.TerminalIn.Close()
 }
 
 type ServerInstance interface {
 	Start() error
 	Stop() error
 	Kill() error
+	Restart() error
+	Update(ServerInstanceConfig) error
+	Remove(purgeData bool) error
+	Logs(context.Context, LogOptions) (LogReader, error)
+	Files() Files
+	Exec(context.Context, ExecOptions) (ExecProcess, error)
+	// Stats samples the server's usage once, or with follow every second
+	// until the context is cancelled or the server stops.
+	Stats(ctx context.Context, follow bool) (StatsReader, error)
+	// CheckImage looks up the latest image for the server's tag without
+	// pulling it.
+	CheckImage(context.Context) (*ImageUpdate, error)
+	// UpdateImage pulls the server's tag and, if it changed, recreates the
+	// server from it, keeping its data.
+	UpdateImage() (*ImageUpdate, error)
 
 	Config() ServerInstanceConfig
 	Status() ServerInstanceStatus
+	// StatusReason explains an errored status, and is otherwise empty.
+	StatusReason() string
+	Crashes() CrashState
 	Events() *ServerInstanceEvents
 	Close()
 }
@@ -53,7 +92,24 @@ type ServerInstance interface {
 type ServerInstanceConfig interface {
 	ID() uuid.UUID
 	Type() ServerInstanceType
+	Metadata() *ServerInstanceMetadata
+	ImageName() string
 	Ports() []int
 	ToJSON() (string, error)
 	NewInstance(context.Context) ServerInstance
+	// HostAccessEqual reports whether other reaches into the host the same
+	// way, through its image, volumes and ports. Only admins may change these.
+	HostAccessEqual(other ServerInstanceConfig) bool
+}
+
+// ServerInstanceMetadata describes a server independently of its type.
+type ServerInstanceMetadata struct {
+	Name        string
+	Description string
+	Labels      map[string]string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// Revision increases with every update. Updates must be made against the
+	// latest revision, so concurrent updates can't overwrite one another.
+	Revision int64
 }
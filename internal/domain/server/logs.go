@@ -0,0 +1,39 @@
+package server
+
+import "time"
+
+type LogStream string
+
+const (
+	LogStreamStdout LogStream = "stdout"
+	LogStreamStderr LogStream = "stderr"
+)
+
+// LogLine is a line a server printed, as recorded by its runtime rather than
+// seen by the console.
+type LogLine struct {
+	Stream LogStream
+	Time   time.Time
+	Text   string
+}
+
+// LogOptions selects which of a server's logs to read. Zero values leave the
+// corresponding bound off.
+type LogOptions struct {
+	Since time.Time
+	Until time.Time
+	// Tail limits the logs to this many of the most recent lines, if set.
+	Tail *int
+	// Follow keeps reading new lines until the context is cancelled.
+	Follow bool
+
+	Stdout bool
+	Stderr bool
+}
+
+// LogReader reads a server's logs a line at a time.
+type LogReader interface {
+	// Next returns the next line, or io.EOF once there are none left.
+	Next() (LogLine, error)
+	Close() error
+}
@@ -0,0 +1,38 @@
+package server
+
+import "time"
+
+type ReadinessProbeType string
+
+const (
+	ReadinessProbeTypeTCP     ReadinessProbeType = "tcp"
+	ReadinessProbeTypeHTTP    ReadinessProbeType = "http"
+	ReadinessProbeTypeConsole ReadinessProbeType = "console"
+	ReadinessProbeTypeExec    ReadinessProbeType = "exec"
+)
+
+const (
+	DefaultReadinessProbeInterval = 5 * time.Second
+	DefaultReadinessProbeTimeout  = 10 * time.Minute
+)
+
+// ReadinessProbe decides when a starting server is ready to be considered
+// running. Should it not pass within Timeout, the server is errored.
+type ReadinessProbe struct {
+	Type ReadinessProbeType `json:"type"`
+	// Port is the host port connected to by TCP and HTTP probes.
+	Port int `json:"port,omitempty"`
+	// Path is requested by HTTP probes, which pass on any 2xx or 3xx response.
+	Path string `json:"path,omitempty"`
+	// Pattern is a regular expression console probes match each line of the
+	// server's output against.
+	Pattern string `json:"pattern,omitempty"`
+	// Command is run within the server by exec probes, which pass once it
+	// exits 0.
+	Command []string `json:"command,omitempty"`
+
+	// Interval is how often the probe is attempted, and how long each attempt
+	// may take. Console probes instead watch the output as it's printed.
+	Interval time.Duration `json:"interval,omitempty"`
+	Timeout  time.Duration `json:"timeout,omitempty"`
+}
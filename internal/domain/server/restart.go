@@ -0,0 +1,47 @@
+package server
+
+import "time"
+
+type RestartMode string
+
+const (
+	RestartModeNever     RestartMode = "never"
+	RestartModeOnFailure RestartMode = "on-failure"
+	RestartModeAlways    RestartMode = "always"
+)
+
+// RestartPolicy decides whether a server is restarted after exiting on its
+// own. Restarts back off exponentially, and once MaxRetries restarts in a row
+// have failed the server is left errored.
+type RestartPolicy struct {
+	// Mode is never when empty.
+	Mode RestartMode `json:"mode,omitempty"`
+	// MaxRetries is unlimited when zero.
+	MaxRetries int `json:"maxRetries,omitempty"`
+}
+
+// ShouldRestart reports whether a server which exited with exitCode should be
+// restarted.
+func (rp RestartPolicy) ShouldRestart(exitCode int) bool {
+	switch rp.Mode {
+	case RestartModeAlways:
+		return true
+	case RestartModeOnFailure:
+		return exitCode != 0
+	default:
+		return false
+	}
+}
+
+// CrashState records how a server has been exiting on its own. It's kept for
+// as long as the daemon runs.
+type CrashState struct {
+	// ExitCode is what the server last exited on its own with, at LastExit.
+	// Neither is set if it hasn't.
+	ExitCode int
+	LastExit time.Time
+	// Count is how many times the server has exited on its own with a non-zero
+	// exit code.
+	Count     int
+	LastCrash time.Time
+}
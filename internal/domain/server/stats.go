@@ -0,0 +1,28 @@
+package server
+
+import "time"
+
+// Stats is a server's resource usage at a point in time.
+type Stats struct {
+	Time time.Time
+	// CPUPercent is relative to a single core, so a server busy on two cores
+	// reaches 200.
+	CPUPercent float64
+	// MemoryUsage leaves out the page cache, which the kernel can reclaim.
+	MemoryUsage int64
+	MemoryLimit int64
+	// NetworkRx, NetworkTx, BlockRead and BlockWrite are bytes in total since
+	// the server was started.
+	NetworkRx  int64
+	NetworkTx  int64
+	BlockRead  int64
+	BlockWrite int64
+	PIDs       int64
+}
+
+// StatsReader reads a server's usage a sample at a time.
+type StatsReader interface {
+	// Next returns the next sample, or io.EOF once there are none left.
+	Next() (Stats, error)
+	Close() error
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"time"
+
+	"oppossome/serverpouch/internal/common/events"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/google/uuid"
+)
+
+// ServerStatusEvent describes a server instance moving between statuses.
+type ServerStatusEvent struct {
+	ID        uuid.UUID
+	OldStatus server.ServerInstanceStatus
+	NewStatus server.ServerInstanceStatus
+	Time      time.Time
+}
+
+// StatusEvents emits every status change across all server instances,
+// including those created after listening.
+func (usc *usecasesImpl) StatusEvents() events.EventEmitter[ServerStatusEvent] {
+	return usc.statusEvents
+}
+
+// watchServer forwards an instance's status changes to the StatusEvents
+// emitter until the instance is closed.
+func (usc *usecasesImpl) watchServer(inst server.ServerInstance) {
+	// Read the status before listening, as the instance holds its lock while
+	// dispatching and we wouldn't be receiving yet.
+	oldStatus := inst.Status()
+	statusChan := inst.Events().Status.On()
+
+	// Likewise the ID, since reading the config takes the same lock.
+	id := inst.Config().ID()
+
+	go func() {
+		for newStatus := range statusChan {
+			// Statuses are refreshed periodically, so only forward actual changes.
+			if newStatus == oldStatus {
+				continue
+			}
+
+			usc.statusEvents.Dispatch(ServerStatusEvent{
+				ID:        id,
+				OldStatus: oldStatus,
+				NewStatus: newStatus,
+				Time:      time.Now(),
+			})
+
+			oldStatus = newStatus
+		}
+	}()
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// DefaultServersLimit is the page size used when none is requested.
+const DefaultServersLimit = 50
+
+// ErrInvalidCursor is returned when a pagination cursor can't be decoded, or
+// was issued for a different sort order.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type ServerSort string
+
+const (
+	ServerSortCreatedAt ServerSort = "createdAt"
+	ServerSortName      ServerSort = "name"
+)
+
+type ListServersOptions struct {
+	// User limits the results to the servers the user can view, if set.
+	User *auth.User
+
+	// Statuses and Types match any of their values, if not empty.
+	Statuses []server.ServerInstanceStatus
+	Types    []server.ServerInstanceType
+	Image    string
+
+	// Labels match servers carrying every label. An empty value only requires
+	// the label to be present.
+	Labels map[string]string
+
+	Sort       ServerSort
+	Descending bool
+	Cursor     string
+	Limit      int
+}
+
+func (opts *ListServersOptions) matches(inst server.ServerInstance) bool {
+	cfg := inst.Config()
+
+	if opts.User != nil && !opts.User.Can(cfg.ID(), auth.PermissionView) {
+		return false
+	}
+
+	if len(opts.Statuses) > 0 && !slices.Contains(opts.Statuses, inst.Status()) {
+		return false
+	}
+
+	if len(opts.Types) > 0 && !slices.Contains(opts.Types, cfg.Type()) {
+		return false
+	}
+
+	if opts.Image != "" && cfg.ImageName() != opts.Image {
+		return false
+	}
+
+	labels := cfg.Metadata().Labels
+	for key, value := range opts.Labels {
+		actual, ok := labels[key]
+		if !ok || (value != "" && actual != value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compare orders servers by the sort key, falling back to their IDs so the
+// order is total and stable between pages.
+func (opts *ListServersOptions) compare(a, b *serverCursor) int {
+	var result int
+	switch opts.Sort {
+	case ServerSortName:
+		result = strings.Compare(a.Name, b.Name)
+	default:
+		result = a.CreatedAt.Compare(b.CreatedAt)
+	}
+
+	if result == 0 {
+		result = bytes.Compare(a.ID[:], b.ID[:])
+	}
+
+	if opts.Descending {
+		return -result
+	}
+
+	return result
+}
+
+// serverCursor holds a server's sort key. The last one of a page is handed out
+// as the cursor to the next.
+type serverCursor struct {
+	Sort       ServerSort `json:"s"`
+	Descending bool       `json:"d,omitempty"`
+	ID         uuid.UUID  `json:"i"`
+	Name       string     `json:"n,omitempty"`
+	CreatedAt  time.Time  `json:"c,omitzero"`
+
+	inst server.ServerInstance
+}
+
+func newServerCursor(inst server.ServerInstance, opts *ListServersOptions) *serverCursor {
+	cfg := inst.Config()
+	cursor := &serverCursor{Sort: opts.Sort, Descending: opts.Descending, ID: cfg.ID(), inst: inst}
+
+	switch opts.Sort {
+	case ServerSortName:
+		cursor.Name = cfg.Metadata().Name
+	default:
+		cursor.CreatedAt = cfg.Metadata().CreatedAt
+	}
+
+	return cursor
+}
+
+func (sc *serverCursor) encode() string {
+	// Marshalling plain strings, IDs and times can't fail.
+	data, _ := json.Marshal(sc)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeServerCursor(cursor string, opts *ListServersOptions) (*serverCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	var sc serverCursor
+	if err := json.Unmarshal(data, &sc); err != nil {
+		return nil, errors.WithStack(ErrInvalidCursor)
+	}
+
+	if sc.Sort != opts.Sort || sc.Descending != opts.Descending {
+		return nil, errors.Wrap(ErrInvalidCursor, "cursor was issued for a different sort")
+	}
+
+	return &sc, nil
+}
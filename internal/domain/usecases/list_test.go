@@ -0,0 +1,107 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/infrastructure/docker"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestInstance(t *testing.T, name string, createdAt time.Time, status server.ServerInstanceStatus, labels map[string]string) server.ServerInstance {
+	inst := mockServer.NewMockServerInstance(t)
+	inst.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{
+		InstanceID: uuid.New(),
+		Meta:       server.ServerInstanceMetadata{Name: name, Labels: labels, CreatedAt: createdAt},
+		Image:      "image-" + name,
+	}).Maybe()
+	inst.EXPECT().Status().Return(status).Maybe()
+
+	return inst
+}
+
+func TestListServers(t *testing.T) {
+	now := time.Now()
+	insts := []server.ServerInstance{
+		newTestInstance(t, "charlie", now, server.ServerInstanceStatusRunning, map[string]string{"game": "minecraft"}),
+		newTestInstance(t, "alpha", now.Add(time.Minute), server.ServerInstanceStatusIdle, map[string]string{"game": "factorio"}),
+		newTestInstance(t, "bravo", now.Add(2*time.Minute), server.ServerInstanceStatusRunning, map[string]string{}),
+	}
+
+	usc := &usecasesImpl{srvInstances: map[uuid.UUID]server.ServerInstance{}}
+	for _, inst := range insts {
+		usc.srvInstances[inst.Config().ID()] = inst
+	}
+
+	names := func(insts []server.ServerInstance) []string {
+		names := []string{}
+		for _, inst := range insts {
+			names = append(names, inst.Config().Metadata().Name)
+		}
+		return names
+	}
+
+	t.Run("Sorted by creation", func(t *testing.T) {
+		res, cursor, err := usc.ListServers(t.Context(), ListServersOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, cursor)
+		assert.Equal(t, []string{"charlie", "alpha", "bravo"}, names(res))
+	})
+
+	t.Run("Sorted by name descending", func(t *testing.T) {
+		res, _, err := usc.ListServers(t.Context(), ListServersOptions{Sort: ServerSortName, Descending: true})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"charlie", "bravo", "alpha"}, names(res))
+	})
+
+	t.Run("Filtered", func(t *testing.T) {
+		res, _, err := usc.ListServers(t.Context(), ListServersOptions{
+			Statuses: []server.ServerInstanceStatus{server.ServerInstanceStatusRunning},
+			Labels:   map[string]string{"game": ""},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"charlie"}, names(res))
+
+		res, _, err = usc.ListServers(t.Context(), ListServersOptions{Image: "image-bravo"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bravo"}, names(res))
+	})
+
+	t.Run("Only visible to the user", func(t *testing.T) {
+		user := &auth.User{Grants: map[uuid.UUID]auth.Role{insts[1].Config().ID(): auth.RoleViewer}}
+
+		res, _, err := usc.ListServers(t.Context(), ListServersOptions{User: user})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"alpha"}, names(res))
+	})
+
+	t.Run("Paginated", func(t *testing.T) {
+		opts := ListServersOptions{Sort: ServerSortName, Limit: 2}
+
+		res, cursor, err := usc.ListServers(t.Context(), opts)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"alpha", "bravo"}, names(res))
+		assert.NotEmpty(t, cursor)
+
+		opts.Cursor = cursor
+		res, cursor, err = usc.ListServers(t.Context(), opts)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"charlie"}, names(res))
+		assert.Empty(t, cursor)
+	})
+
+	t.Run("Cursor of another sort", func(t *testing.T) {
+		_, cursor, err := usc.ListServers(t.Context(), ListServersOptions{Limit: 1})
+		assert.NoError(t, err)
+
+		_, _, err = usc.ListServers(t.Context(), ListServersOptions{Sort: ServerSortName, Cursor: cursor})
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+}
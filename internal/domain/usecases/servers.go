@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"oppossome/serverpouch/internal/domain/server"
 
@@ -11,16 +12,63 @@ import (
 	"github.com/rs/zerolog"
 )
 
-func (usc *usecasesImpl) ListServers(ctx context.Context) []server.ServerInstance {
-	usc.srvMu.RLock()
-	defer usc.srvMu.RUnlock()
+// ListServers returns the instances matching the options, along with a cursor
+// to the next page if there is one.
+func (usc *usecasesImpl) ListServers(ctx context.Context, opts ListServersOptions) ([]server.ServerInstance, string, error) {
+	if opts.Sort == "" {
+		opts.Sort = ServerSortCreatedAt
+	}
 
-	insts := make([]server.ServerInstance, 0, len(usc.srvInstances))
+	var after *serverCursor
+	if opts.Cursor != "" {
+		cursor, err := decodeServerCursor(opts.Cursor, &opts)
+		if err != nil {
+			return nil, "", err
+		}
+
+		after = cursor
+	}
+
+	// Resolve every sort key up front, as each one requires locking the instance.
+	usc.srvMu.RLock()
+	matches := make([]*serverCursor, 0, len(usc.srvInstances))
 	for _, instance := range usc.srvInstances {
-		insts = append(insts, instance)
+		if opts.matches(instance) {
+			matches = append(matches, newServerCursor(instance, &opts))
+		}
+	}
+	usc.srvMu.RUnlock()
+
+	slices.SortFunc(matches, opts.compare)
+
+	if after != nil {
+		start, _ := slices.BinarySearchFunc(matches, after, func(match, after *serverCursor) int {
+			// Treat the cursor itself as smaller, so we start right after it.
+			if cmp := opts.compare(match, after); cmp != 0 {
+				return cmp
+			}
+			return -1
+		})
+		matches = matches[start:]
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultServersLimit
+	}
+
+	var nextCursor string
+	if len(matches) > limit {
+		matches = matches[:limit]
+		nextCursor = matches[limit-1].encode()
 	}
 
-	return insts
+	insts := make([]server.ServerInstance, len(matches))
+	for idx, match := range matches {
+		insts[idx] = match.inst
+	}
+
+	return insts, nextCursor, nil
 }
 
 func (usc *usecasesImpl) GetServer(ctx context.Context, id uuid.UUID) (server.ServerInstance, error) {
@@ -30,7 +78,7 @@ func (usc *usecasesImpl) GetServer(ctx context.Context, id uuid.UUID) (server.Se
 	inst, ok := usc.srvInstances[id]
 	if !ok {
 		zerolog.Ctx(ctx).Error().Str("id", id.String()).Msg("instance not found")
-		return nil, fmt.Errorf("instance of ID \"%s\" not found", id.String())
+		return nil, errors.Wrapf(server.ErrInstanceNotFound, "instance of ID \"%s\"", id.String())
 	}
 
 	return inst, nil
@@ -47,6 +95,125 @@ func (usc *usecasesImpl) CreateServer(ctx context.Context, cfg server.ServerInst
 	usc.srvMu.Lock()
 	defer usc.srvMu.Unlock()
 	usc.srvInstances[dbCfg.ID()] = inst
+	usc.watchServer(inst)
+
+	return inst, nil
+}
+
+func (usc *usecasesImpl) UpdateServer(ctx context.Context, id uuid.UUID, cfg server.ServerInstanceConfig) (server.ServerInstance, error) {
+	inst, err := usc.GetServer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Type() != inst.Config().Type() {
+		return nil, &server.InvalidConfigError{
+			Field:  "/config/type",
+			Reason: fmt.Sprintf("unable to change server type from %s to %s", inst.Config().Type(), cfg.Type()),
+		}
+	}
+
+	prevCfg := inst.Config()
+	dbCfg, err := usc.db.UpdateServer(ctx, id, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write config to db")
+	}
+
+	if err := inst.Update(dbCfg); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("id", id.String()).Msg("failed to apply config")
+
+		// The instance keeps its previous config, so the db has to as well, or
+		// their revisions would no longer match and every update would fail.
+		restoreErr := usc.db.RestoreServer(context.WithoutCancel(ctx), id, prevCfg, dbCfg.Metadata().Revision)
+		if restoreErr != nil {
+			zerolog.Ctx(ctx).Err(restoreErr).Str("id", id.String()).Msg("failed to restore config")
+		}
+
+		return nil, errors.Wrap(err, "failed to apply config")
+	}
+
+	return inst, nil
+}
+
+func (usc *usecasesImpl) DeleteServer(ctx context.Context, id uuid.UUID, purgeData bool) error {
+	inst, err := usc.GetServer(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Remove the container first, so a failure leaves everything in place to retry.
+	if err := inst.Remove(purgeData); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("id", id.String()).Msg("failed to remove instance")
+		return errors.Wrap(err, "failed to remove instance")
+	}
+
+	if err := usc.db.DeleteServer(ctx, id); err != nil {
+		return errors.Wrap(err, "failed to delete config from db")
+	}
+
+	usc.srvMu.Lock()
+	delete(usc.srvInstances, id)
+	usc.srvMu.Unlock()
+
+	inst.Close()
+	return nil
+}
+
+func (usc *usecasesImpl) StartServer(ctx context.Context, id uuid.UUID) (server.ServerInstance, error) {
+	return usc.serverAction(ctx, id, "start", server.ServerInstance.Start)
+}
+
+func (usc *usecasesImpl) StopServer(ctx context.Context, id uuid.UUID) (server.ServerInstance, error) {
+	return usc.serverAction(ctx, id, "stop", server.ServerInstance.Stop)
+}
+
+func (usc *usecasesImpl) KillServer(ctx context.Context, id uuid.UUID) (server.ServerInstance, error) {
+	return usc.serverAction(ctx, id, "kill", server.ServerInstance.Kill)
+}
+
+func (usc *usecasesImpl) RestartServer(ctx context.Context, id uuid.UUID) (server.ServerInstance, error) {
+	return usc.serverAction(ctx, id, "restart", server.ServerInstance.Restart)
+}
+
+func (usc *usecasesImpl) CheckServerImage(ctx context.Context, id uuid.UUID) (*server.ImageUpdate, error) {
+	inst, err := usc.GetServer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := inst.CheckImage(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("id", id.String()).Msg("failed to check instance image")
+		return nil, errors.Wrap(err, "failed to check instance image")
+	}
+
+	return update, nil
+}
+
+func (usc *usecasesImpl) UpdateServerImage(ctx context.Context, id uuid.UUID) (server.ServerInstance, *server.ImageUpdate, error) {
+	var update *server.ImageUpdate
+	inst, err := usc.serverAction(ctx, id, "update image of", func(inst server.ServerInstance) (err error) {
+		update, err = inst.UpdateImage()
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inst, update, nil
+}
+
+// serverAction looks up an instance and performs the provided lifecycle action on it.
+func (usc *usecasesImpl) serverAction(ctx context.Context, id uuid.UUID, name string, action func(server.ServerInstance) error) (server.ServerInstance, error) {
+	inst, err := usc.GetServer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := action(inst); err != nil {
+		zerolog.Ctx(ctx).Err(err).Str("id", id.String()).Msgf("failed to %s instance", name)
+		return nil, errors.Wrapf(err, "failed to %s instance", name)
+	}
 
 	return inst, nil
 }
@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/infrastructure/docker"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+	mockDatabase "oppossome/serverpouch/internal/common/test/mocks/infrastructure/database"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// otherConfig is a config of some type other than docker.
+type otherConfig struct {
+	*docker.DockerServerInstanceOptions
+}
+
+func (otherConfig) Type() server.ServerInstanceType {
+	return "other"
+}
+
+func TestUpdateServer(t *testing.T) {
+	t.Run("Error - Can't change the server type", func(t *testing.T) {
+		cfg := &docker.DockerServerInstanceOptions{InstanceID: uuid.New(), Image: "test"}
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(cfg)
+
+		usc := &usecasesImpl{
+			db:           mockDatabase.NewMockDatabase(t),
+			srvInstances: map[uuid.UUID]server.ServerInstance{cfg.InstanceID: inst},
+		}
+
+		_, err := usc.UpdateServer(t.Context(), cfg.InstanceID, otherConfig{cfg})
+
+		var configErr *server.InvalidConfigError
+		assert.ErrorAs(t, err, &configErr)
+		assert.Equal(t, "/config/type", configErr.Field)
+	})
+
+	t.Run("Error - Restores the config when it can't be applied", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+
+		prevCfg := &docker.DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "old",
+			Meta:       server.ServerInstanceMetadata{Revision: 3},
+		}
+		cfg := &docker.DockerServerInstanceOptions{
+			InstanceID: prevCfg.InstanceID,
+			Image:      "new",
+			Meta:       server.ServerInstanceMetadata{Revision: 3},
+		}
+		dbCfg := &docker.DockerServerInstanceOptions{
+			InstanceID: prevCfg.InstanceID,
+			Image:      "new",
+			Meta:       server.ServerInstanceMetadata{Revision: 4},
+		}
+
+		inst := mockServer.NewMockServerInstance(t)
+		inst.EXPECT().Config().Return(prevCfg)
+		inst.EXPECT().Update(dbCfg).Return(errors.New("Unable to stop container"))
+
+		usc := &usecasesImpl{
+			db:           mockDB,
+			srvInstances: map[uuid.UUID]server.ServerInstance{prevCfg.InstanceID: inst},
+		}
+
+		mockDB.EXPECT().UpdateServer(mock.Anything, prevCfg.InstanceID, cfg).Return(dbCfg, nil)
+		mockDB.EXPECT().RestoreServer(mock.Anything, prevCfg.InstanceID, prevCfg, int64(4)).Return(nil)
+
+		_, err := usc.UpdateServer(t.Context(), prevCfg.InstanceID, cfg)
+		assert.ErrorContains(t, err, "Unable to stop container")
+	})
+}
@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Running servers are sampled every minute, with those samples kept for a
+// day. Each hour they're summarized into hourly samples, which are kept for a
+// month.
+const (
+	StatsSampleResolution = time.Minute
+	StatsHourlyResolution = time.Hour
+
+	statsSampleRetention = 24 * time.Hour
+	statsHourlyRetention = 30 * 24 * time.Hour
+)
+
+// StatsHistory is a server's recorded usage over a span of time.
+type StatsHistory struct {
+	// Resolution is how long each sample covers.
+	Resolution time.Duration
+	Samples    []server.Stats
+}
+
+// GetServerStatsHistory returns the server's usage between since and until, at
+// the finest resolution still kept for all of it.
+func (usc *usecasesImpl) GetServerStatsHistory(ctx context.Context, id uuid.UUID, since time.Time, until time.Time) (*StatsHistory, error) {
+	if _, err := usc.GetServer(ctx, id); err != nil {
+		return nil, err
+	}
+
+	resolution := StatsSampleResolution
+	if since.Before(time.Now().Add(-statsSampleRetention)) {
+		resolution = StatsHourlyResolution
+	}
+
+	samples, err := usc.db.ListServerStats(ctx, id, resolution, since, until)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list server stats")
+	}
+
+	if resolution == StatsHourlyResolution {
+		// Hours are only downsampled once they're over, so whatever comes after
+		// the last of them is summarized from the samples instead.
+		recent := since
+		if len(samples) > 0 {
+			recent = samples[len(samples)-1].Time.Add(StatsHourlyResolution)
+		}
+
+		if recent.Before(until) {
+			recentSamples, err := usc.db.ListServerStats(ctx, id, StatsSampleResolution, recent, until)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to list server stats")
+			}
+
+			samples = append(samples, summarizeStats(recentSamples, StatsHourlyResolution)...)
+		}
+	}
+
+	return &StatsHistory{Resolution: resolution, Samples: samples}, nil
+}
+
+// summarizeStats buckets the samples by the resolution, as downsampling does.
+// Usage is averaged over each bucket, while the totals only grow so the
+// largest is kept. The samples are expected in order.
+func summarizeStats(samples []server.Stats, resolution time.Duration) []server.Stats {
+	summaries := []server.Stats{}
+
+	for start := 0; start < len(samples); {
+		bucket := samples[start].Time.Truncate(resolution)
+
+		end := start
+		for end < len(samples) && samples[end].Time.Truncate(resolution).Equal(bucket) {
+			end++
+		}
+
+		summary := server.Stats{Time: bucket}
+		var cpuPercent float64
+		var memoryUsage, pids int64
+		for _, stats := range samples[start:end] {
+			cpuPercent += stats.CPUPercent
+			memoryUsage += stats.MemoryUsage
+			pids += stats.PIDs
+
+			summary.MemoryLimit = max(summary.MemoryLimit, stats.MemoryLimit)
+			summary.NetworkRx = max(summary.NetworkRx, stats.NetworkRx)
+			summary.NetworkTx = max(summary.NetworkTx, stats.NetworkTx)
+			summary.BlockRead = max(summary.BlockRead, stats.BlockRead)
+			summary.BlockWrite = max(summary.BlockWrite, stats.BlockWrite)
+		}
+
+		count := float64(end - start)
+		summary.CPUPercent = cpuPercent / count
+		summary.MemoryUsage = int64(math.Round(float64(memoryUsage) / count))
+		summary.PIDs = int64(math.Round(float64(pids) / count))
+
+		summaries = append(summaries, summary)
+		start = end
+	}
+
+	return summaries
+}
+
+// MARK: sampleStats
+
+// sampleStats records the usage of every running server each minute, and
+// downsamples and prunes what's been recorded each hour, until ctx is done.
+func (usc *usecasesImpl) sampleStats(ctx context.Context) {
+	ticker := time.NewTicker(StatsSampleResolution)
+	defer ticker.Stop()
+
+	// Downsample straight away, in case we weren't running at the last hour.
+	// Samples older than their retention are gone, so that's as far back as
+	// there can be anything left to downsample.
+	downsampled := usc.downsampleStats(ctx, time.Now().Add(-statsSampleRetention), time.Now())
+	lastDownsample := time.Now().Truncate(time.Hour)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case now := <-ticker.C:
+			usc.recordStats(ctx)
+
+			if hour := now.Truncate(time.Hour); hour.After(lastDownsample) {
+				downsampled = usc.downsampleStats(ctx, downsampled, now)
+				lastDownsample = hour
+			}
+		}
+	}
+}
+
+// recordStats samples every running server. Each sample takes docker about a
+// second, so they're taken together.
+func (usc *usecasesImpl) recordStats(ctx context.Context) {
+	usc.srvMu.RLock()
+	insts := make([]server.ServerInstance, 0, len(usc.srvInstances))
+	for _, inst := range usc.srvInstances {
+		if inst.Status() == server.ServerInstanceStatusRunning {
+			insts = append(insts, inst)
+		}
+	}
+	usc.srvMu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, inst := range insts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := usc.recordServerStats(ctx, inst); err != nil && ctx.Err() == nil {
+				zerolog.Ctx(ctx).Warn().Err(err).Stringer("id", inst.Config().ID()).Msg("failed to record server stats")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (usc *usecasesImpl) recordServerStats(ctx context.Context, inst server.ServerInstance) error {
+	reader, err := inst.Stats(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	stats, err := reader.Next()
+	if err != nil {
+		return errors.Wrap(err, "failed to sample server stats")
+	}
+
+	return usc.db.CreateServerStats(ctx, inst.Config().ID(), StatsSampleResolution, stats)
+}
+
+// downsampleStats summarizes every complete hour of samples since the given
+// time, then drops those past their retention. It returns the time up to which
+// samples have been summarized, for the next call to start from.
+func (usc *usecasesImpl) downsampleStats(ctx context.Context, since time.Time, now time.Time) time.Time {
+	since = since.Truncate(StatsHourlyResolution)
+	before := now.Truncate(StatsHourlyResolution)
+
+	err := usc.db.DownsampleServerStats(ctx, StatsSampleResolution, StatsHourlyResolution, since, before)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to downsample server stats")
+		return since
+	}
+
+	if err := usc.db.DeleteServerStats(ctx, StatsSampleResolution, now.Add(-statsSampleRetention)); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to prune server stats")
+	}
+
+	if err := usc.db.DeleteServerStats(ctx, StatsHourlyResolution, now.Add(-statsHourlyRetention)); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("failed to prune server stats")
+	}
+
+	return before
+}
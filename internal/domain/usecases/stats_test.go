@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/infrastructure/docker"
+
+	mockServer "oppossome/serverpouch/internal/common/test/mocks/domain/server"
+	mockDatabase "oppossome/serverpouch/internal/common/test/mocks/infrastructure/database"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// testStatsReader reads back a single sample.
+type testStatsReader struct {
+	stats *server.Stats
+}
+
+func (tsr *testStatsReader) Next() (server.Stats, error) {
+	if tsr.stats == nil {
+		return server.Stats{}, io.EOF
+	}
+
+	stats := *tsr.stats
+	tsr.stats = nil
+	return stats, nil
+}
+
+func (tsr *testStatsReader) Close() error {
+	return nil
+}
+
+func TestRecordStats(t *testing.T) {
+	t.Run("Ok - Samples running servers", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		stats := server.Stats{Time: time.Now(), CPUPercent: 12.5, MemoryUsage: 1024}
+
+		running := mockServer.NewMockServerInstance(t)
+		running.EXPECT().Config().Return(&docker.DockerServerInstanceOptions{InstanceID: uuid.New()})
+		running.EXPECT().Status().Return(server.ServerInstanceStatusRunning)
+		running.EXPECT().Stats(mock.Anything, false).Return(&testStatsReader{stats: &stats}, nil)
+
+		idle := newTestInstance(t, "idle", time.Now(), server.ServerInstanceStatusIdle, nil)
+
+		usc := &usecasesImpl{
+			db: mockDB,
+			srvInstances: map[uuid.UUID]server.ServerInstance{
+				running.Config().ID(): running,
+				idle.Config().ID():    idle,
+			},
+		}
+
+		mockDB.EXPECT().CreateServerStats(mock.Anything, running.Config().ID(), StatsSampleResolution, stats).Return(nil)
+
+		usc.recordStats(t.Context())
+	})
+}
+
+func TestDownsampleStats(t *testing.T) {
+	t.Run("Ok - Summarizes complete hours and prunes", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB}
+
+		since := time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC)
+		now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		mockDB.EXPECT().DownsampleServerStats(mock.Anything, StatsSampleResolution, StatsHourlyResolution, since, time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)).Return(nil)
+		mockDB.EXPECT().DeleteServerStats(mock.Anything, StatsSampleResolution, now.Add(-statsSampleRetention)).Return(nil)
+		mockDB.EXPECT().DeleteServerStats(mock.Anything, StatsHourlyResolution, now.Add(-statsHourlyRetention)).Return(nil)
+
+		downsampled := usc.downsampleStats(t.Context(), since, now)
+		assert.Equal(t, time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC), downsampled)
+	})
+
+	t.Run("Err - Retries from the same time", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB}
+
+		since := time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC)
+		now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		mockDB.EXPECT().DownsampleServerStats(mock.Anything, StatsSampleResolution, StatsHourlyResolution, since, mock.Anything).Return(errors.New("connection refused"))
+
+		assert.Equal(t, since, usc.downsampleStats(t.Context(), since, now))
+	})
+}
+
+func TestSummarizeStats(t *testing.T) {
+	hour := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	samples := []server.Stats{
+		{Time: hour, CPUPercent: 10, MemoryUsage: 100, MemoryLimit: 1000, NetworkRx: 1, PIDs: 4},
+		{Time: hour.Add(30 * time.Minute), CPUPercent: 30, MemoryUsage: 300, MemoryLimit: 1000, NetworkRx: 5, PIDs: 5},
+		{Time: hour.Add(time.Hour), CPUPercent: 50, MemoryUsage: 500, MemoryLimit: 2000, NetworkRx: 9, PIDs: 6},
+	}
+
+	assert.Equal(t, []server.Stats{
+		{Time: hour, CPUPercent: 20, MemoryUsage: 200, MemoryLimit: 1000, NetworkRx: 5, PIDs: 5},
+		{Time: hour.Add(time.Hour), CPUPercent: 50, MemoryUsage: 500, MemoryLimit: 2000, NetworkRx: 9, PIDs: 6},
+	}, summarizeStats(samples, time.Hour))
+	assert.Empty(t, summarizeStats(nil, time.Hour))
+}
+
+func TestGetServerStatsHistory(t *testing.T) {
+	inst := newTestInstance(t, "test", time.Now(), server.ServerInstanceStatusRunning, nil)
+	id := inst.Config().ID()
+
+	t.Run("Ok - Recent history is per minute", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB, srvInstances: map[uuid.UUID]server.ServerInstance{id: inst}}
+
+		since, until := time.Now().Add(-time.Hour), time.Now()
+		mockDB.EXPECT().ListServerStats(mock.Anything, id, StatsSampleResolution, since, until).Return([]server.Stats{}, nil)
+
+		history, err := usc.GetServerStatsHistory(t.Context(), id, since, until)
+		assert.NoError(t, err)
+		assert.Equal(t, StatsSampleResolution, history.Resolution)
+	})
+
+	t.Run("Ok - Older history is per hour", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB, srvInstances: map[uuid.UUID]server.ServerInstance{id: inst}}
+
+		since, until := time.Now().Add(-7*24*time.Hour), time.Now()
+		last := until.Truncate(time.Hour).Add(-time.Hour)
+		mockDB.EXPECT().ListServerStats(mock.Anything, id, StatsHourlyResolution, since, until).Return([]server.Stats{{Time: last}}, nil)
+		mockDB.EXPECT().ListServerStats(mock.Anything, id, StatsSampleResolution, last.Add(time.Hour), until).Return([]server.Stats{}, nil)
+
+		history, err := usc.GetServerStatsHistory(t.Context(), id, since, until)
+		assert.NoError(t, err)
+		assert.Equal(t, StatsHourlyResolution, history.Resolution)
+	})
+
+	t.Run("Ok - The current hour is summarized from samples", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB, srvInstances: map[uuid.UUID]server.ServerInstance{id: inst}}
+
+		since, until := time.Now().Add(-7*24*time.Hour), time.Now()
+		last := until.Truncate(time.Hour).Add(-time.Hour)
+		current := until.Truncate(time.Hour)
+		mockDB.EXPECT().ListServerStats(mock.Anything, id, StatsHourlyResolution, since, until).Return([]server.Stats{{Time: last, CPUPercent: 5}}, nil)
+		mockDB.EXPECT().ListServerStats(mock.Anything, id, StatsSampleResolution, current, until).Return([]server.Stats{
+			{Time: current, CPUPercent: 10},
+			{Time: current, CPUPercent: 30},
+		}, nil)
+
+		history, err := usc.GetServerStatsHistory(t.Context(), id, since, until)
+		assert.NoError(t, err)
+		assert.Equal(t, []server.Stats{{Time: last, CPUPercent: 5}, {Time: current, CPUPercent: 20}}, history.Samples)
+	})
+
+	t.Run("Err - Not Found", func(t *testing.T) {
+		usc := &usecasesImpl{srvInstances: map[uuid.UUID]server.ServerInstance{}}
+
+		_, err := usc.GetServerStatsHistory(t.Context(), uuid.New(), time.Now(), time.Now())
+		assert.ErrorIs(t, err, server.ErrInstanceNotFound)
+	})
+}
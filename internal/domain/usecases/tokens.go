@@ -0,0 +1,116 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"oppossome/serverpouch/internal/domain/auth"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+func (usc *usecasesImpl) GetAPIToken(ctx context.Context, id uuid.UUID) (*auth.APIToken, error) {
+	return usc.db.GetAPIToken(ctx, id)
+}
+
+func (usc *usecasesImpl) ListAPITokens(ctx context.Context) ([]*auth.APIToken, error) {
+	tokens, err := usc.db.ListAPITokens(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve tokens from db")
+	}
+
+	return tokens, nil
+}
+
+func (usc *usecasesImpl) ListUserAPITokens(ctx context.Context, userID uuid.UUID) ([]*auth.APIToken, error) {
+	tokens, err := usc.db.ListUserAPITokens(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve tokens from db")
+	}
+
+	return tokens, nil
+}
+
+// CreateAPIToken creates a new token for the user, returning it alongside its
+// secret. The secret can't be recovered afterwards.
+func (usc *usecasesImpl) CreateAPIToken(ctx context.Context, userID uuid.UUID, name string) (*auth.APIToken, string, error) {
+	secret, hash, err := auth.GenerateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, err := usc.db.CreateAPIToken(ctx, userID, name, hash)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to write token to db")
+	}
+
+	return token, secret, nil
+}
+
+func (usc *usecasesImpl) RevokeAPIToken(ctx context.Context, id uuid.UUID) (*auth.APIToken, error) {
+	token, err := usc.db.RevokeAPIToken(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to revoke token")
+	}
+
+	return token, nil
+}
+
+// Authenticate resolves a secret to the user owning it.
+func (usc *usecasesImpl) Authenticate(ctx context.Context, secret string) (*auth.User, error) {
+	token, err := usc.db.GetAPITokenByHash(ctx, auth.HashToken(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	// Usage tracking is best effort, it shouldn't fail the request.
+	_ = usc.db.TouchAPIToken(ctx, token.ID)
+
+	user, err := usc.db.GetUser(ctx, token.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve token owner")
+	}
+
+	return user, nil
+}
+
+// bootstrapAPIToken creates an admin along with a token for them when there
+// are no admins yet, otherwise nobody would be able to use the API on a fresh
+// install. Once there's an admin their tokens are left alone, so that revoking
+// the last of them isn't undone by a restart.
+func (usc *usecasesImpl) bootstrapAPIToken(ctx context.Context) error {
+	users, err := usc.db.ListUsers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Failed to retrieve users")
+	}
+
+	taken := map[string]bool{}
+	for _, user := range users {
+		if user.IsAdmin {
+			return nil
+		}
+
+		taken[user.Name] = true
+	}
+
+	// Someone may have already taken the name without being an admin.
+	name := "admin"
+	for idx := 2; taken[name]; idx++ {
+		name = fmt.Sprintf("admin-%d", idx)
+	}
+
+	admin, err := usc.db.CreateUser(ctx, name, true)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create admin user")
+	}
+
+	_, secret, err := usc.CreateAPIToken(ctx, admin.ID, "bootstrap")
+	if err != nil {
+		return errors.Wrap(err, "Failed to create bootstrap token")
+	}
+
+	zerolog.Ctx(ctx).Warn().Str("user", admin.Name).Str("token", secret).Msg("No admins found, created one with a token. Store it safely, it won't be shown again")
+	return nil
+}
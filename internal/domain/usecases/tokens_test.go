@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/auth"
+
+	mockDatabase "oppossome/serverpouch/internal/common/test/mocks/infrastructure/database"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBootstrapAPIToken(t *testing.T) {
+	t.Run("Ok - Creates an admin on a fresh install", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB}
+
+		admin := &auth.User{ID: uuid.New(), Name: "admin", IsAdmin: true}
+		mockDB.EXPECT().ListUsers(mock.Anything).Return([]*auth.User{}, nil)
+		mockDB.EXPECT().CreateUser(mock.Anything, "admin", true).Return(admin, nil)
+		mockDB.EXPECT().CreateAPIToken(mock.Anything, admin.ID, "bootstrap", mock.Anything).Return(&auth.APIToken{}, nil)
+
+		assert.NoError(t, usc.bootstrapAPIToken(t.Context()))
+	})
+
+	t.Run("Ok - Leaves existing admins alone", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB}
+
+		// Even if they have no tokens left, since they may have been revoked.
+		mockDB.EXPECT().ListUsers(mock.Anything).Return([]*auth.User{{ID: uuid.New(), Name: "root", IsAdmin: true}}, nil)
+
+		assert.NoError(t, usc.bootstrapAPIToken(t.Context()))
+	})
+
+	t.Run("Ok - Picks another name when admin is taken", func(t *testing.T) {
+		mockDB := mockDatabase.NewMockDatabase(t)
+		usc := &usecasesImpl{db: mockDB}
+
+		admin := &auth.User{ID: uuid.New(), Name: "admin-2", IsAdmin: true}
+		mockDB.EXPECT().ListUsers(mock.Anything).Return([]*auth.User{{ID: uuid.New(), Name: "admin"}}, nil)
+		mockDB.EXPECT().CreateUser(mock.Anything, "admin-2", true).Return(admin, nil)
+		mockDB.EXPECT().CreateAPIToken(mock.Anything, admin.ID, "bootstrap", mock.Anything).Return(&auth.APIToken{}, nil)
+
+		assert.NoError(t, usc.bootstrapAPIToken(t.Context()))
+	})
+}
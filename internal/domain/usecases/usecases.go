@@ -3,7 +3,10 @@ package usecases
 import (
 	"context"
 	"sync"
+	"time"
 
+	"oppossome/serverpouch/internal/common/events"
+	"oppossome/serverpouch/internal/domain/auth"
 	"oppossome/serverpouch/internal/domain/server"
 	"oppossome/serverpouch/internal/infrastructure/database"
 
@@ -13,9 +16,34 @@ import (
 )
 
 type Usecases interface {
-	ListServers(context.Context) []server.ServerInstance
+	ListServers(context.Context, ListServersOptions) ([]server.ServerInstance, string, error)
 	GetServer(context.Context, uuid.UUID) (server.ServerInstance, error)
 	CreateServer(context.Context, server.ServerInstanceConfig) (server.ServerInstance, error)
+	UpdateServer(context.Context, uuid.UUID, server.ServerInstanceConfig) (server.ServerInstance, error)
+	DeleteServer(ctx context.Context, id uuid.UUID, purgeData bool) error
+	StartServer(context.Context, uuid.UUID) (server.ServerInstance, error)
+	StopServer(context.Context, uuid.UUID) (server.ServerInstance, error)
+	KillServer(context.Context, uuid.UUID) (server.ServerInstance, error)
+	RestartServer(context.Context, uuid.UUID) (server.ServerInstance, error)
+	CheckServerImage(context.Context, uuid.UUID) (*server.ImageUpdate, error)
+	UpdateServerImage(context.Context, uuid.UUID) (server.ServerInstance, *server.ImageUpdate, error)
+	StatusEvents() events.EventEmitter[ServerStatusEvent]
+	GetServerStatsHistory(ctx context.Context, id uuid.UUID, since time.Time, until time.Time) (*StatsHistory, error)
+
+	GetAPIToken(context.Context, uuid.UUID) (*auth.APIToken, error)
+	ListAPITokens(context.Context) ([]*auth.APIToken, error)
+	ListUserAPITokens(context.Context, uuid.UUID) ([]*auth.APIToken, error)
+	CreateAPIToken(ctx context.Context, userID uuid.UUID, name string) (*auth.APIToken, string, error)
+	RevokeAPIToken(context.Context, uuid.UUID) (*auth.APIToken, error)
+	Authenticate(ctx context.Context, secret string) (*auth.User, error)
+
+	GetUser(context.Context, uuid.UUID) (*auth.User, error)
+	ListUsers(context.Context) ([]*auth.User, error)
+	CreateUser(ctx context.Context, name string, isAdmin bool) (*auth.User, error)
+	DeleteUser(context.Context, uuid.UUID) error
+	SetServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role) (*auth.User, error)
+	DeleteServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID) (*auth.User, error)
+
 	Close()
 }
 
@@ -24,6 +52,11 @@ type usecasesImpl struct {
 
 	srvMu        sync.RWMutex
 	srvInstances map[uuid.UUID]server.ServerInstance
+
+	statusEvents events.EventEmitter[ServerStatusEvent]
+
+	statsCancel context.CancelFunc
+	statsDone   chan struct{}
 }
 
 var _ Usecases = (*usecasesImpl)(nil)
@@ -34,6 +67,8 @@ func New(ctx context.Context) (*usecasesImpl, error) {
 
 		srvMu:        sync.RWMutex{},
 		srvInstances: make(map[uuid.UUID]server.ServerInstance),
+
+		statusEvents: events.New[ServerStatusEvent]("server_status"),
 	}
 
 	err := usecases.init(ctx)
@@ -41,6 +76,14 @@ func New(ctx context.Context) (*usecasesImpl, error) {
 		return nil, errors.Wrap(err, "failed to initialize usecases")
 	}
 
+	statsCtx, statsCancel := context.WithCancel(ctx)
+	usecases.statsCancel = statsCancel
+	usecases.statsDone = make(chan struct{})
+	go func() {
+		defer close(usecases.statsDone)
+		usecases.sampleStats(statsCtx)
+	}()
+
 	zerolog.Ctx(ctx).Debug().Msg("usecases initialized")
 	return usecases, nil
 }
@@ -49,13 +92,19 @@ func (usc *usecasesImpl) init(ctx context.Context) error {
 	usc.srvMu.Lock()
 	defer usc.srvMu.Unlock()
 
+	if err := usc.bootstrapAPIToken(ctx); err != nil {
+		return err
+	}
+
 	srvConfigs, err := usc.db.ListServers(ctx)
 	if err != nil {
 		return errors.Wrap(err, "Failed to retrieve configs")
 	}
 
 	for _, config := range srvConfigs {
-		usc.srvInstances[config.ID()] = config.NewInstance(ctx)
+		inst := config.NewInstance(ctx)
+		usc.srvInstances[config.ID()] = inst
+		usc.watchServer(inst)
 	}
 
 	zerolog.Ctx(ctx).Debug().Msgf("%d server instances loaded", len(usc.srvInstances))
@@ -63,6 +112,9 @@ func (usc *usecasesImpl) init(ctx context.Context) error {
 }
 
 func (usc *usecasesImpl) Close() {
+	usc.statsCancel()
+	<-usc.statsDone
+
 	var wg sync.WaitGroup
 	wg.Add(len(usc.srvInstances))
 	for _, config := range usc.srvInstances {
@@ -73,4 +125,5 @@ func (usc *usecasesImpl) Close() {
 	}
 
 	wg.Wait()
+	usc.statusEvents.Close()
 }
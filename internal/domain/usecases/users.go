@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+
+	"oppossome/serverpouch/internal/domain/auth"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (usc *usecasesImpl) GetUser(ctx context.Context, id uuid.UUID) (*auth.User, error) {
+	return usc.db.GetUser(ctx, id)
+}
+
+func (usc *usecasesImpl) ListUsers(ctx context.Context) ([]*auth.User, error) {
+	users, err := usc.db.ListUsers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve users from db")
+	}
+
+	return users, nil
+}
+
+func (usc *usecasesImpl) CreateUser(ctx context.Context, name string, isAdmin bool) (*auth.User, error) {
+	user, err := usc.db.CreateUser(ctx, name, isAdmin)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write user to db")
+	}
+
+	return user, nil
+}
+
+func (usc *usecasesImpl) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	return usc.db.DeleteUser(ctx, id)
+}
+
+// SetServerGrant grants the user a role on the server, replacing any role
+// they previously held on it.
+func (usc *usecasesImpl) SetServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role) (*auth.User, error) {
+	if !role.Valid() {
+		return nil, errors.Wrapf(auth.ErrInvalidRole, "unknown role \"%s\"", role)
+	}
+
+	if _, err := usc.GetServer(ctx, serverID); err != nil {
+		return nil, err
+	}
+
+	if _, err := usc.db.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if err := usc.db.SetServerGrant(ctx, userID, serverID, role); err != nil {
+		return nil, errors.Wrap(err, "failed to write grant to db")
+	}
+
+	return usc.db.GetUser(ctx, userID)
+}
+
+func (usc *usecasesImpl) DeleteServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID) (*auth.User, error) {
+	if _, err := usc.db.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if err := usc.db.DeleteServerGrant(ctx, userID, serverID); err != nil {
+		return nil, errors.Wrap(err, "failed to delete grant from db")
+	}
+
+	return usc.db.GetUser(ctx, userID)
+}
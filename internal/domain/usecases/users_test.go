@@ -0,0 +1,25 @@
+package usecases
+
+import (
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/domain/server"
+
+	mockDatabase "oppossome/serverpouch/internal/common/test/mocks/infrastructure/database"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetServerGrant(t *testing.T) {
+	t.Run("Error - Unknown role", func(t *testing.T) {
+		usc := &usecasesImpl{
+			db:           mockDatabase.NewMockDatabase(t),
+			srvInstances: map[uuid.UUID]server.ServerInstance{},
+		}
+
+		_, err := usc.SetServerGrant(t.Context(), uuid.New(), uuid.New(), auth.Role("owner"))
+		assert.ErrorIs(t, err, auth.ErrInvalidRole)
+	})
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/infrastructure/database/schema"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+func convertToAPIToken(schema *schema.ApiToken) *auth.APIToken {
+	return &auth.APIToken{
+		ID:         schema.ID,
+		UserID:     schema.UserID,
+		Name:       schema.Name,
+		CreatedAt:  schema.CreatedAt.Time,
+		LastUsedAt: convertToTimePtr(schema.LastUsedAt),
+		RevokedAt:  convertToTimePtr(schema.RevokedAt),
+	}
+}
+
+func convertToTimePtr(timestamp pgtype.Timestamptz) *time.Time {
+	if !timestamp.Valid {
+		return nil
+	}
+
+	return &timestamp.Time
+}
+
+func (d *databaseImpl) GetAPIToken(ctx context.Context, id uuid.UUID) (*auth.APIToken, error) {
+	dbToken, err := d.queries.GetAPIToken(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(auth.ErrTokenNotFound, "token of ID \"%s\"", id)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve api token")
+		return nil, errors.Wrap(err, "failed to retrieve api token")
+	}
+
+	return convertToAPIToken(&dbToken), nil
+}
+
+func (d *databaseImpl) GetAPITokenByHash(ctx context.Context, hash []byte) (*auth.APIToken, error) {
+	dbToken, err := d.queries.GetAPITokenByHash(ctx, hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.WithStack(auth.ErrInvalidToken)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve api token")
+		return nil, errors.Wrap(err, "failed to retrieve api token")
+	}
+
+	return convertToAPIToken(&dbToken), nil
+}
+
+func (d *databaseImpl) ListAPITokens(ctx context.Context) ([]*auth.APIToken, error) {
+	dbTokens, err := d.queries.GetAPITokens(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve api tokens")
+		return nil, errors.Wrap(err, "failed to retrieve api tokens")
+	}
+
+	return convertToAPITokens(dbTokens), nil
+}
+
+func (d *databaseImpl) ListUserAPITokens(ctx context.Context, userID uuid.UUID) ([]*auth.APIToken, error) {
+	dbTokens, err := d.queries.GetUserAPITokens(ctx, userID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve api tokens")
+		return nil, errors.Wrap(err, "failed to retrieve api tokens")
+	}
+
+	return convertToAPITokens(dbTokens), nil
+}
+
+func convertToAPITokens(dbTokens []schema.ApiToken) []*auth.APIToken {
+	tokens := make([]*auth.APIToken, len(dbTokens))
+	for idx, dbToken := range dbTokens {
+		tokens[idx] = convertToAPIToken(&dbToken)
+	}
+
+	return tokens
+}
+
+func (d *databaseImpl) CreateAPIToken(ctx context.Context, userID uuid.UUID, name string, hash []byte) (*auth.APIToken, error) {
+	dbToken, err := d.queries.CreateAPIToken(ctx, schema.CreateAPITokenParams{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hash,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to create api token")
+		return nil, errors.Wrap(err, "failed to create api token")
+	}
+
+	return convertToAPIToken(&dbToken), nil
+}
+
+func (d *databaseImpl) RevokeAPIToken(ctx context.Context, id uuid.UUID) (*auth.APIToken, error) {
+	dbToken, err := d.queries.RevokeAPIToken(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(auth.ErrTokenNotFound, "token of ID \"%s\"", id)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to revoke api token")
+		return nil, errors.Wrap(err, "failed to revoke api token")
+	}
+
+	return convertToAPIToken(&dbToken), nil
+}
+
+func (d *databaseImpl) TouchAPIToken(ctx context.Context, id uuid.UUID) error {
+	err := d.queries.TouchAPIToken(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to update api token usage")
+		return errors.Wrap(err, "failed to update api token usage")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package database_test
+
+import (
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/infrastructure/database"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAPITokenByHash(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		user, err := dbRepo.CreateUser(t.Context(), "test", false)
+		assert.NoError(t, err)
+
+		hash := auth.HashToken("sp_test")
+		token, err := dbRepo.CreateAPIToken(t.Context(), user.ID, "test", hash)
+		assert.NoError(t, err)
+
+		dbToken, err := dbRepo.GetAPITokenByHash(t.Context(), hash)
+		assert.NoError(t, err)
+		assert.Equal(t, token, dbToken)
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		user, err := dbRepo.CreateUser(t.Context(), "test", false)
+		assert.NoError(t, err)
+
+		hash := auth.HashToken("sp_test")
+		token, err := dbRepo.CreateAPIToken(t.Context(), user.ID, "test", hash)
+		assert.NoError(t, err)
+
+		revoked, err := dbRepo.RevokeAPIToken(t.Context(), token.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, revoked.RevokedAt)
+
+		_, err = dbRepo.GetAPITokenByHash(t.Context(), hash)
+		assert.True(t, errors.Is(err, auth.ErrInvalidToken))
+	})
+}
+
+func TestRevokeAPIToken(t *testing.T) {
+	t.Run("Not Found", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		_, err := dbRepo.RevokeAPIToken(t.Context(), uuid.New())
+		assert.True(t, errors.Is(err, auth.ErrTokenNotFound))
+	})
+}
+
+func TestListAPITokens(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		user, err := dbRepo.CreateUser(t.Context(), "test", false)
+		assert.NoError(t, err)
+
+		token, err := dbRepo.CreateAPIToken(t.Context(), user.ID, "test", auth.HashToken("sp_test"))
+		assert.NoError(t, err)
+
+		assert.NoError(t, dbRepo.TouchAPIToken(t.Context(), token.ID))
+
+		tokens, err := dbRepo.ListAPITokens(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(tokens))
+		assert.Equal(t, token.ID, tokens[0].ID)
+		assert.NotNil(t, tokens[0].LastUsedAt)
+	})
+}
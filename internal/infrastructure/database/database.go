@@ -3,12 +3,14 @@ package database
 import (
 	"context"
 	"testing"
+	"time"
 
+	"oppossome/serverpouch/internal/domain/auth"
 	"oppossome/serverpouch/internal/domain/server"
 	"oppossome/serverpouch/internal/infrastructure/database/schema"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
@@ -20,26 +22,59 @@ type Database interface {
 	GetServer(context.Context, uuid.UUID) (server.ServerInstanceConfig, error)
 	ListServers(context.Context) ([]server.ServerInstanceConfig, error)
 	UpdateServer(context.Context, uuid.UUID, server.ServerInstanceConfig) (server.ServerInstanceConfig, error)
+	// RestoreServer puts back config, as it was before the update which made
+	// revision, should that update fail to apply.
+	RestoreServer(ctx context.Context, id uuid.UUID, config server.ServerInstanceConfig, revision int64) error
 	CreateServer(context.Context, server.ServerInstanceConfig) (server.ServerInstanceConfig, error)
+	DeleteServer(context.Context, uuid.UUID) error
+
+	CreateServerStats(ctx context.Context, serverID uuid.UUID, resolution time.Duration, stats server.Stats) error
+	ListServerStats(ctx context.Context, serverID uuid.UUID, resolution time.Duration, since time.Time, until time.Time) ([]server.Stats, error)
+	DownsampleServerStats(ctx context.Context, from time.Duration, to time.Duration, since time.Time, before time.Time) error
+	DeleteServerStats(ctx context.Context, resolution time.Duration, before time.Time) error
+
+	GetAPIToken(context.Context, uuid.UUID) (*auth.APIToken, error)
+	GetAPITokenByHash(context.Context, []byte) (*auth.APIToken, error)
+	ListAPITokens(context.Context) ([]*auth.APIToken, error)
+	ListUserAPITokens(context.Context, uuid.UUID) ([]*auth.APIToken, error)
+	CreateAPIToken(ctx context.Context, userID uuid.UUID, name string, hash []byte) (*auth.APIToken, error)
+	RevokeAPIToken(context.Context, uuid.UUID) (*auth.APIToken, error)
+	TouchAPIToken(context.Context, uuid.UUID) error
+
+	GetUser(context.Context, uuid.UUID) (*auth.User, error)
+	ListUsers(context.Context) ([]*auth.User, error)
+	CreateUser(ctx context.Context, name string, isAdmin bool) (*auth.User, error)
+	DeleteUser(context.Context, uuid.UUID) error
+	SetServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role) error
+	DeleteServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID) error
+
+	Ping(context.Context) error
 }
 
 type databaseImpl struct {
-	conn    *pgx.Conn
+	pool    *pgxpool.Pool
 	queries *schema.Queries
 }
 
 var _ Database = (*databaseImpl)(nil)
 
 func New(ctx context.Context, connStr string) (*databaseImpl, error) {
-	conn, err := pgx.Connect(ctx, connStr)
+	// Requests are handled concurrently, so they each need a connection.
+	pool, err := pgxpool.New(ctx, connStr)
 	if err != nil {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to connect to database")
 		return nil, errors.Wrap(err, "failed to connect to database")
 	}
 
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to connect to database")
+		return nil, errors.Wrap(err, "failed to connect to database")
+	}
+
 	database := &databaseImpl{
-		conn:    conn,
-		queries: schema.New(conn),
+		pool:    pool,
+		queries: schema.New(pool),
 	}
 
 	zerolog.Ctx(ctx).Debug().Msg("connected to database")
@@ -55,10 +90,16 @@ func NewTestDatabase(t *testing.T) (*schema.Queries, *databaseImpl) {
 	dbImpl, err := New(t.Context(), connStr)
 	assert.NoError(t, err)
 
-	t.Cleanup(func() {
-		err := dbImpl.conn.Close(t.Context())
-		assert.NoError(t, err, "failed to close database connection")
-	})
+	t.Cleanup(dbImpl.pool.Close)
 
 	return dbImpl.queries, dbImpl
 }
+
+// Ping checks the database can be reached.
+func (d *databaseImpl) Ping(ctx context.Context) error {
+	if err := d.pool.Ping(ctx); err != nil {
+		return errors.Wrap(err, "failed to ping database")
+	}
+
+	return nil
+}
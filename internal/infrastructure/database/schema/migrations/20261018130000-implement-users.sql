@@ -0,0 +1,31 @@
+
+-- +migrate Up
+
+CREATE TABLE users (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL UNIQUE,
+  is_admin BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE server_grants (
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  server_id UUID NOT NULL REFERENCES servers (id) ON DELETE CASCADE,
+  role TEXT NOT NULL CHECK (role IN ('viewer', 'moderator', 'manager')),
+  PRIMARY KEY (user_id, server_id)
+);
+
+-- Tokens created before users existed keep working as admin tokens.
+INSERT INTO users (name, is_admin)
+SELECT 'admin', TRUE
+WHERE EXISTS (SELECT 1 FROM api_tokens);
+
+ALTER TABLE api_tokens ADD COLUMN user_id UUID REFERENCES users (id) ON DELETE CASCADE;
+UPDATE api_tokens SET user_id = (SELECT id FROM users WHERE name = 'admin');
+ALTER TABLE api_tokens ALTER COLUMN user_id SET NOT NULL;
+
+-- +migrate Down
+
+ALTER TABLE api_tokens DROP COLUMN user_id;
+DROP TABLE server_grants;
+DROP TABLE users;
@@ -0,0 +1,16 @@
+
+-- +migrate Up
+
+ALTER TABLE servers ADD COLUMN name TEXT;
+UPDATE servers SET name = id::TEXT;
+ALTER TABLE servers ALTER COLUMN name SET NOT NULL;
+
+ALTER TABLE servers ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX servers_name_idx ON servers (name);
+
+-- +migrate Down
+
+DROP INDEX servers_name_idx;
+ALTER TABLE servers DROP COLUMN labels;
+ALTER TABLE servers DROP COLUMN name;
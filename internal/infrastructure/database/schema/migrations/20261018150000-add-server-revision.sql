@@ -0,0 +1,8 @@
+
+-- +migrate Up
+
+ALTER TABLE servers ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
+
+-- +migrate Down
+
+ALTER TABLE servers DROP COLUMN revision;
@@ -0,0 +1,8 @@
+
+-- +migrate Up
+
+ALTER TABLE servers ADD COLUMN description TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+
+ALTER TABLE servers DROP COLUMN description;
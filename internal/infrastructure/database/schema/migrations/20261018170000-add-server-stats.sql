@@ -0,0 +1,23 @@
+
+-- +migrate Up
+
+-- Samples are kept at several resolutions, each the number of seconds the
+-- sample covers, with older samples only kept at the coarser ones.
+CREATE TABLE server_stats (
+  server_id UUID NOT NULL REFERENCES servers (id) ON DELETE CASCADE,
+  resolution INTEGER NOT NULL,
+  sampled_at TIMESTAMPTZ NOT NULL,
+  cpu_percent DOUBLE PRECISION NOT NULL,
+  memory_usage BIGINT NOT NULL,
+  memory_limit BIGINT NOT NULL,
+  network_rx BIGINT NOT NULL,
+  network_tx BIGINT NOT NULL,
+  block_read BIGINT NOT NULL,
+  block_write BIGINT NOT NULL,
+  pids BIGINT NOT NULL,
+  PRIMARY KEY (server_id, resolution, sampled_at)
+);
+
+-- +migrate Down
+
+DROP TABLE server_stats;
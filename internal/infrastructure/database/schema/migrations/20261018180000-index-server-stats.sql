@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- Downsampling and pruning work through every server's samples at a
+-- resolution by time, which the primary key can't help with.
+CREATE INDEX server_stats_resolution_sampled_at ON server_stats (resolution, sampled_at);
+
+-- +migrate Down
+
+DROP INDEX server_stats_resolution_sampled_at;
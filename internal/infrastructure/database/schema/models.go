@@ -9,10 +9,51 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiToken struct {
+	ID         uuid.UUID
+	Name       string
+	TokenHash  []byte
+	CreatedAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+	RevokedAt  pgtype.Timestamptz
+	UserID     uuid.UUID
+}
+
 type Server struct {
+	ID          uuid.UUID
+	Type        string
+	Config      []byte
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	Name        string
+	Labels      []byte
+	Revision    int64
+	Description string
+}
+
+type ServerGrant struct {
+	UserID   uuid.UUID
+	ServerID uuid.UUID
+	Role     string
+}
+
+type ServerStat struct {
+	ServerID    uuid.UUID
+	Resolution  int32
+	SampledAt   pgtype.Timestamptz
+	CpuPercent  float64
+	MemoryUsage int64
+	MemoryLimit int64
+	NetworkRx   int64
+	NetworkTx   int64
+	BlockRead   int64
+	BlockWrite  int64
+	Pids        int64
+}
+
+type User struct {
 	ID        uuid.UUID
-	Type      string
-	Config    []byte
+	Name      string
+	IsAdmin   bool
 	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
 }
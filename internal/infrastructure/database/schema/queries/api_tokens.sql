@@ -0,0 +1,34 @@
+-- name: GetAPIToken :one
+SELECT * FROM api_tokens
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetAPITokenByHash :one
+SELECT * FROM api_tokens
+WHERE token_hash = $1 AND revoked_at IS NULL
+LIMIT 1;
+
+-- name: GetAPITokens :many
+SELECT * FROM api_tokens
+ORDER BY created_at DESC;
+
+-- name: GetUserAPITokens :many
+SELECT * FROM api_tokens
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: CreateAPIToken :one
+INSERT INTO api_tokens (user_id, name, token_hash)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: RevokeAPIToken :one
+UPDATE api_tokens SET
+  revoked_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND revoked_at IS NULL
+RETURNING *;
+
+-- name: TouchAPIToken :exec
+UPDATE api_tokens SET
+  last_used_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - INTERVAL '1 minute');
@@ -0,0 +1,43 @@
+-- name: CreateServerStats :exec
+INSERT INTO server_stats (
+  server_id, resolution, sampled_at, cpu_percent, memory_usage, memory_limit,
+  network_rx, network_tx, block_read, block_write, pids
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT DO NOTHING;
+
+-- name: GetServerStats :many
+SELECT * FROM server_stats
+WHERE server_id = $1 AND resolution = $2
+  AND sampled_at >= sqlc.arg(since) AND sampled_at < sqlc.arg(until)
+ORDER BY sampled_at;
+
+-- Usage is averaged over each bucket, while the totals only grow so the
+-- largest is kept. Buckets that were already downsampled are left alone, so
+-- since and before should fall on a bucket's boundary.
+-- name: DownsampleServerStats :exec
+INSERT INTO server_stats (
+  server_id, resolution, sampled_at, cpu_percent, memory_usage, memory_limit,
+  network_rx, network_tx, block_read, block_write, pids
+)
+SELECT
+  samples.server_id,
+  sqlc.arg(to_resolution)::INTEGER,
+  date_bin(make_interval(secs => sqlc.arg(to_resolution)::INTEGER), samples.sampled_at, to_timestamp(0)) AS bucket,
+  avg(samples.cpu_percent)::DOUBLE PRECISION,
+  avg(samples.memory_usage)::BIGINT,
+  max(samples.memory_limit)::BIGINT,
+  max(samples.network_rx)::BIGINT,
+  max(samples.network_tx)::BIGINT,
+  max(samples.block_read)::BIGINT,
+  max(samples.block_write)::BIGINT,
+  round(avg(samples.pids))::BIGINT
+FROM server_stats AS samples
+WHERE samples.resolution = sqlc.arg(from_resolution)::INTEGER
+  AND samples.sampled_at >= sqlc.arg(since)::TIMESTAMPTZ AND samples.sampled_at < sqlc.arg(before)::TIMESTAMPTZ
+GROUP BY samples.server_id, bucket
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteServerStats :exec
+DELETE FROM server_stats
+WHERE resolution = $1 AND sampled_at < $2;
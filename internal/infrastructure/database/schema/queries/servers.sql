@@ -7,13 +7,31 @@ SELECT * FROM servers
 ORDER BY created_at DESC;
 
 -- name: CreateServer :one
-INSERT INTO servers (type, config) 
-VALUES ($1, $2)
+INSERT INTO servers (type, config, name, labels, description) 
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: UpdateServer :one
 UPDATE servers SET 
   config = $2, 
+  name = $3,
+  labels = $4,
+  description = $6,
+  revision = revision + 1,
   updated_at = CURRENT_TIMESTAMP
-WHERE id = $1 
-RETURNING *;
\ No newline at end of file
+WHERE id = $1 AND revision = $5
+RETURNING *;
+
+-- name: DeleteServer :exec
+DELETE FROM servers
+WHERE id = $1;
+
+-- name: RestoreServer :exec
+UPDATE servers SET
+  config = $2,
+  name = $3,
+  labels = $4,
+  description = $5,
+  revision = $6,
+  updated_at = $7
+WHERE id = $1 AND revision = sqlc.arg(current_revision);
@@ -0,0 +1,34 @@
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetUsers :many
+SELECT * FROM users
+ORDER BY name;
+
+-- name: CreateUser :one
+INSERT INTO users (name, is_admin)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE id = $1;
+
+-- name: GetServerGrants :many
+SELECT * FROM server_grants
+WHERE user_id = $1;
+
+-- name: GetAllServerGrants :many
+SELECT * FROM server_grants;
+
+-- name: SetServerGrant :exec
+INSERT INTO server_grants (user_id, server_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, server_id) DO UPDATE SET
+  role = EXCLUDED.role;
+
+-- name: DeleteServerGrant :execrows
+DELETE FROM server_grants
+WHERE user_id = $1 AND server_id = $2;
@@ -0,0 +1,163 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: server_stats.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createServerStats = `-- name: CreateServerStats :exec
+INSERT INTO server_stats (
+  server_id, resolution, sampled_at, cpu_percent, memory_usage, memory_limit,
+  network_rx, network_tx, block_read, block_write, pids
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT DO NOTHING
+`
+
+type CreateServerStatsParams struct {
+	ServerID    uuid.UUID
+	Resolution  int32
+	SampledAt   pgtype.Timestamptz
+	CpuPercent  float64
+	MemoryUsage int64
+	MemoryLimit int64
+	NetworkRx   int64
+	NetworkTx   int64
+	BlockRead   int64
+	BlockWrite  int64
+	Pids        int64
+}
+
+func (q *Queries) CreateServerStats(ctx context.Context, arg CreateServerStatsParams) error {
+	_, err := q.db.Exec(ctx, createServerStats,
+		arg.ServerID,
+		arg.Resolution,
+		arg.SampledAt,
+		arg.CpuPercent,
+		arg.MemoryUsage,
+		arg.MemoryLimit,
+		arg.NetworkRx,
+		arg.NetworkTx,
+		arg.BlockRead,
+		arg.BlockWrite,
+		arg.Pids,
+	)
+	return err
+}
+
+const deleteServerStats = `-- name: DeleteServerStats :exec
+DELETE FROM server_stats
+WHERE resolution = $1 AND sampled_at < $2
+`
+
+type DeleteServerStatsParams struct {
+	Resolution int32
+	SampledAt  pgtype.Timestamptz
+}
+
+func (q *Queries) DeleteServerStats(ctx context.Context, arg DeleteServerStatsParams) error {
+	_, err := q.db.Exec(ctx, deleteServerStats, arg.Resolution, arg.SampledAt)
+	return err
+}
+
+const downsampleServerStats = `-- name: DownsampleServerStats :exec
+INSERT INTO server_stats (
+  server_id, resolution, sampled_at, cpu_percent, memory_usage, memory_limit,
+  network_rx, network_tx, block_read, block_write, pids
+)
+SELECT
+  samples.server_id,
+  $1::INTEGER,
+  date_bin(make_interval(secs => $1::INTEGER), samples.sampled_at, to_timestamp(0)) AS bucket,
+  avg(samples.cpu_percent)::DOUBLE PRECISION,
+  avg(samples.memory_usage)::BIGINT,
+  max(samples.memory_limit)::BIGINT,
+  max(samples.network_rx)::BIGINT,
+  max(samples.network_tx)::BIGINT,
+  max(samples.block_read)::BIGINT,
+  max(samples.block_write)::BIGINT,
+  round(avg(samples.pids))::BIGINT
+FROM server_stats AS samples
+WHERE samples.resolution = $2::INTEGER
+  AND samples.sampled_at >= $3::TIMESTAMPTZ AND samples.sampled_at < $4::TIMESTAMPTZ
+GROUP BY samples.server_id, bucket
+ON CONFLICT DO NOTHING
+`
+
+type DownsampleServerStatsParams struct {
+	ToResolution   int32
+	FromResolution int32
+	Since          pgtype.Timestamptz
+	Before         pgtype.Timestamptz
+}
+
+// Usage is averaged over each bucket, while the totals only grow so the
+// largest is kept. Buckets that were already downsampled are left alone, so
+// since and before should fall on a bucket's boundary.
+func (q *Queries) DownsampleServerStats(ctx context.Context, arg DownsampleServerStatsParams) error {
+	_, err := q.db.Exec(ctx, downsampleServerStats,
+		arg.ToResolution,
+		arg.FromResolution,
+		arg.Since,
+		arg.Before,
+	)
+	return err
+}
+
+const getServerStats = `-- name: GetServerStats :many
+SELECT server_id, resolution, sampled_at, cpu_percent, memory_usage, memory_limit, network_rx, network_tx, block_read, block_write, pids FROM server_stats
+WHERE server_id = $1 AND resolution = $2
+  AND sampled_at >= $3 AND sampled_at < $4
+ORDER BY sampled_at
+`
+
+type GetServerStatsParams struct {
+	ServerID   uuid.UUID
+	Resolution int32
+	Since      pgtype.Timestamptz
+	Until      pgtype.Timestamptz
+}
+
+func (q *Queries) GetServerStats(ctx context.Context, arg GetServerStatsParams) ([]ServerStat, error) {
+	rows, err := q.db.Query(ctx, getServerStats,
+		arg.ServerID,
+		arg.Resolution,
+		arg.Since,
+		arg.Until,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServerStat
+	for rows.Next() {
+		var i ServerStat
+		if err := rows.Scan(
+			&i.ServerID,
+			&i.Resolution,
+			&i.SampledAt,
+			&i.CpuPercent,
+			&i.MemoryUsage,
+			&i.MemoryLimit,
+			&i.NetworkRx,
+			&i.NetworkTx,
+			&i.BlockRead,
+			&i.BlockWrite,
+			&i.Pids,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -9,21 +9,31 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createServer = `-- name: CreateServer :one
-INSERT INTO servers (type, config) 
-VALUES ($1, $2)
-RETURNING id, type, config, created_at, updated_at
+INSERT INTO servers (type, config, name, labels, description) 
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, type, config, created_at, updated_at, name, labels, revision, description
 `
 
 type CreateServerParams struct {
-	Type   string
-	Config []byte
+	Type        string
+	Config      []byte
+	Name        string
+	Labels      []byte
+	Description string
 }
 
 func (q *Queries) CreateServer(ctx context.Context, arg CreateServerParams) (Server, error) {
-	row := q.db.QueryRow(ctx, createServer, arg.Type, arg.Config)
+	row := q.db.QueryRow(ctx, createServer,
+		arg.Type,
+		arg.Config,
+		arg.Name,
+		arg.Labels,
+		arg.Description,
+	)
 	var i Server
 	err := row.Scan(
 		&i.ID,
@@ -31,12 +41,26 @@ func (q *Queries) CreateServer(ctx context.Context, arg CreateServerParams) (Ser
 		&i.Config,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Name,
+		&i.Labels,
+		&i.Revision,
+		&i.Description,
 	)
 	return i, err
 }
 
+const deleteServer = `-- name: DeleteServer :exec
+DELETE FROM servers
+WHERE id = $1
+`
+
+func (q *Queries) DeleteServer(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteServer, id)
+	return err
+}
+
 const getServer = `-- name: GetServer :one
-SELECT id, type, config, created_at, updated_at FROM servers
+SELECT id, type, config, created_at, updated_at, name, labels, revision, description FROM servers
 WHERE id = $1 LIMIT 1
 `
 
@@ -49,12 +73,16 @@ func (q *Queries) GetServer(ctx context.Context, id uuid.UUID) (Server, error) {
 		&i.Config,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Name,
+		&i.Labels,
+		&i.Revision,
+		&i.Description,
 	)
 	return i, err
 }
 
 const getServers = `-- name: GetServers :many
-SELECT id, type, config, created_at, updated_at FROM servers
+SELECT id, type, config, created_at, updated_at, name, labels, revision, description FROM servers
 ORDER BY created_at DESC
 `
 
@@ -73,6 +101,10 @@ func (q *Queries) GetServers(ctx context.Context) ([]Server, error) {
 			&i.Config,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Name,
+			&i.Labels,
+			&i.Revision,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -84,21 +116,72 @@ func (q *Queries) GetServers(ctx context.Context) ([]Server, error) {
 	return items, nil
 }
 
+const restoreServer = `-- name: RestoreServer :exec
+UPDATE servers SET
+  config = $2,
+  name = $3,
+  labels = $4,
+  description = $5,
+  revision = $6,
+  updated_at = $7
+WHERE id = $1 AND revision = $8
+`
+
+type RestoreServerParams struct {
+	ID              uuid.UUID
+	Config          []byte
+	Name            string
+	Labels          []byte
+	Description     string
+	Revision        int64
+	UpdatedAt       pgtype.Timestamptz
+	CurrentRevision int64
+}
+
+func (q *Queries) RestoreServer(ctx context.Context, arg RestoreServerParams) error {
+	_, err := q.db.Exec(ctx, restoreServer,
+		arg.ID,
+		arg.Config,
+		arg.Name,
+		arg.Labels,
+		arg.Description,
+		arg.Revision,
+		arg.UpdatedAt,
+		arg.CurrentRevision,
+	)
+	return err
+}
+
 const updateServer = `-- name: UpdateServer :one
 UPDATE servers SET 
   config = $2, 
+  name = $3,
+  labels = $4,
+  description = $6,
+  revision = revision + 1,
   updated_at = CURRENT_TIMESTAMP
-WHERE id = $1 
-RETURNING id, type, config, created_at, updated_at
+WHERE id = $1 AND revision = $5
+RETURNING id, type, config, created_at, updated_at, name, labels, revision, description
 `
 
 type UpdateServerParams struct {
-	ID     uuid.UUID
-	Config []byte
+	ID          uuid.UUID
+	Config      []byte
+	Name        string
+	Labels      []byte
+	Revision    int64
+	Description string
 }
 
 func (q *Queries) UpdateServer(ctx context.Context, arg UpdateServerParams) (Server, error) {
-	row := q.db.QueryRow(ctx, updateServer, arg.ID, arg.Config)
+	row := q.db.QueryRow(ctx, updateServer,
+		arg.ID,
+		arg.Config,
+		arg.Name,
+		arg.Labels,
+		arg.Revision,
+		arg.Description,
+	)
 	var i Server
 	err := row.Scan(
 		&i.ID,
@@ -106,6 +189,10 @@ func (q *Queries) UpdateServer(ctx context.Context, arg UpdateServerParams) (Ser
 		&i.Config,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Name,
+		&i.Labels,
+		&i.Revision,
+		&i.Description,
 	)
 	return i, err
 }
@@ -0,0 +1,181 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: users.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (name, is_admin)
+VALUES ($1, $2)
+RETURNING id, name, is_admin, created_at
+`
+
+type CreateUserParams struct {
+	Name    string
+	IsAdmin bool
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.Name, arg.IsAdmin)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.IsAdmin,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteServerGrant = `-- name: DeleteServerGrant :execrows
+DELETE FROM server_grants
+WHERE user_id = $1 AND server_id = $2
+`
+
+type DeleteServerGrantParams struct {
+	UserID   uuid.UUID
+	ServerID uuid.UUID
+}
+
+func (q *Queries) DeleteServerGrant(ctx context.Context, arg DeleteServerGrantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteServerGrant, arg.UserID, arg.ServerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUser = `-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAllServerGrants = `-- name: GetAllServerGrants :many
+SELECT user_id, server_id, role FROM server_grants
+`
+
+func (q *Queries) GetAllServerGrants(ctx context.Context) ([]ServerGrant, error) {
+	rows, err := q.db.Query(ctx, getAllServerGrants)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServerGrant
+	for rows.Next() {
+		var i ServerGrant
+		if err := rows.Scan(&i.UserID, &i.ServerID, &i.Role); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getServerGrants = `-- name: GetServerGrants :many
+SELECT user_id, server_id, role FROM server_grants
+WHERE user_id = $1
+`
+
+func (q *Queries) GetServerGrants(ctx context.Context, userID uuid.UUID) ([]ServerGrant, error) {
+	rows, err := q.db.Query(ctx, getServerGrants, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServerGrant
+	for rows.Next() {
+		var i ServerGrant
+		if err := rows.Scan(&i.UserID, &i.ServerID, &i.Role); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUser = `-- name: GetUser :one
+SELECT id, name, is_admin, created_at FROM users
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, getUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.IsAdmin,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUsers = `-- name: GetUsers :many
+SELECT id, name, is_admin, created_at FROM users
+ORDER BY name
+`
+
+func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.IsAdmin,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setServerGrant = `-- name: SetServerGrant :exec
+INSERT INTO server_grants (user_id, server_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, server_id) DO UPDATE SET
+  role = EXCLUDED.role
+`
+
+type SetServerGrantParams struct {
+	UserID   uuid.UUID
+	ServerID uuid.UUID
+	Role     string
+}
+
+func (q *Queries) SetServerGrant(ctx context.Context, arg SetServerGrantParams) error {
+	_, err := q.db.Exec(ctx, setServerGrant, arg.UserID, arg.ServerID, arg.Role)
+	return err
+}
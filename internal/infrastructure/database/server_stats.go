@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/infrastructure/database/schema"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Resolutions are stored as the number of seconds each sample covers.
+
+func convertToStats(schema *schema.ServerStat) server.Stats {
+	return server.Stats{
+		Time:        schema.SampledAt.Time,
+		CPUPercent:  schema.CpuPercent,
+		MemoryUsage: schema.MemoryUsage,
+		MemoryLimit: schema.MemoryLimit,
+		NetworkRx:   schema.NetworkRx,
+		NetworkTx:   schema.NetworkTx,
+		BlockRead:   schema.BlockRead,
+		BlockWrite:  schema.BlockWrite,
+		PIDs:        schema.Pids,
+	}
+}
+
+func convertToTimestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+func (d *databaseImpl) CreateServerStats(ctx context.Context, serverID uuid.UUID, resolution time.Duration, stats server.Stats) error {
+	err := d.queries.CreateServerStats(ctx, schema.CreateServerStatsParams{
+		ServerID:    serverID,
+		Resolution:  int32(resolution / time.Second),
+		SampledAt:   convertToTimestamptz(stats.Time),
+		CpuPercent:  stats.CPUPercent,
+		MemoryUsage: stats.MemoryUsage,
+		MemoryLimit: stats.MemoryLimit,
+		NetworkRx:   stats.NetworkRx,
+		NetworkTx:   stats.NetworkTx,
+		BlockRead:   stats.BlockRead,
+		BlockWrite:  stats.BlockWrite,
+		Pids:        stats.PIDs,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to create server stats")
+		return errors.Wrap(err, "failed to create server stats")
+	}
+
+	return nil
+}
+
+func (d *databaseImpl) ListServerStats(ctx context.Context, serverID uuid.UUID, resolution time.Duration, since time.Time, until time.Time) ([]server.Stats, error) {
+	dbStats, err := d.queries.GetServerStats(ctx, schema.GetServerStatsParams{
+		ServerID:   serverID,
+		Resolution: int32(resolution / time.Second),
+		Since:      convertToTimestamptz(since),
+		Until:      convertToTimestamptz(until),
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve server stats")
+		return nil, errors.Wrap(err, "failed to retrieve server stats")
+	}
+
+	stats := make([]server.Stats, len(dbStats))
+	for idx, dbStat := range dbStats {
+		stats[idx] = convertToStats(&dbStat)
+	}
+
+	return stats, nil
+}
+
+// DownsampleServerStats summarizes the samples taken between since and before
+// at one resolution into samples at a coarser one.
+func (d *databaseImpl) DownsampleServerStats(ctx context.Context, from time.Duration, to time.Duration, since time.Time, before time.Time) error {
+	err := d.queries.DownsampleServerStats(ctx, schema.DownsampleServerStatsParams{
+		FromResolution: int32(from / time.Second),
+		ToResolution:   int32(to / time.Second),
+		Since:          convertToTimestamptz(since),
+		Before:         convertToTimestamptz(before),
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to downsample server stats")
+		return errors.Wrap(err, "failed to downsample server stats")
+	}
+
+	return nil
+}
+
+// DeleteServerStats deletes the samples at the resolution taken before the
+// given time.
+func (d *databaseImpl) DeleteServerStats(ctx context.Context, resolution time.Duration, before time.Time) error {
+	err := d.queries.DeleteServerStats(ctx, schema.DeleteServerStatsParams{
+		Resolution: int32(resolution / time.Second),
+		SampledAt:  convertToTimestamptz(before),
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to delete server stats")
+		return errors.Wrap(err, "failed to delete server stats")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+	"oppossome/serverpouch/internal/infrastructure/database"
+	"oppossome/serverpouch/internal/infrastructure/database/schema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerStats(t *testing.T) {
+	t.Run("Ok - Downsamples and prunes", func(t *testing.T) {
+		queries, dbRepo := database.NewTestDatabase(t)
+		ctx := t.Context()
+
+		srvCfg, err := queries.CreateServer(ctx, schema.CreateServerParams{
+			Type:   "docker",
+			Config: []byte(`{"image":"hello-world"}`),
+			Name:   "test",
+			Labels: []byte(`{}`),
+		})
+		assert.NoError(t, err)
+
+		hour := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+		samples := []server.Stats{
+			{Time: hour, CPUPercent: 10, MemoryUsage: 100, MemoryLimit: 1000, NetworkRx: 1, PIDs: 4},
+			{Time: hour.Add(30 * time.Minute), CPUPercent: 30, MemoryUsage: 300, MemoryLimit: 1000, NetworkRx: 5, PIDs: 5},
+			// The next hour isn't complete, so it's left alone.
+			{Time: hour.Add(time.Hour), CPUPercent: 50, MemoryUsage: 500, MemoryLimit: 1000, NetworkRx: 9, PIDs: 6},
+		}
+		for _, stats := range samples {
+			assert.NoError(t, dbRepo.CreateServerStats(ctx, srvCfg.ID, time.Minute, stats))
+		}
+
+		minutes, err := dbRepo.ListServerStats(ctx, srvCfg.ID, time.Minute, hour, hour.Add(2*time.Hour))
+		assert.NoError(t, err)
+		assert.Len(t, minutes, 3)
+		assert.Equal(t, 30.0, minutes[1].CPUPercent)
+
+		before := hour.Add(time.Hour)
+		// Only the samples since the given time are looked at.
+		assert.NoError(t, dbRepo.DownsampleServerStats(ctx, time.Minute, time.Hour, before, before))
+		hours, err := dbRepo.ListServerStats(ctx, srvCfg.ID, time.Hour, hour, hour.Add(2*time.Hour))
+		assert.NoError(t, err)
+		assert.Empty(t, hours)
+
+		assert.NoError(t, dbRepo.DownsampleServerStats(ctx, time.Minute, time.Hour, hour, before))
+		// Downsampling again doesn't change what's already there.
+		assert.NoError(t, dbRepo.DownsampleServerStats(ctx, time.Minute, time.Hour, hour, before))
+
+		hours, err = dbRepo.ListServerStats(ctx, srvCfg.ID, time.Hour, hour, hour.Add(2*time.Hour))
+		assert.NoError(t, err)
+		assert.Len(t, hours, 1)
+		assert.True(t, hour.Equal(hours[0].Time))
+		assert.Equal(t, 20.0, hours[0].CPUPercent)
+		assert.Equal(t, int64(200), hours[0].MemoryUsage)
+		assert.Equal(t, int64(5), hours[0].NetworkRx)
+
+		assert.NoError(t, dbRepo.DeleteServerStats(ctx, time.Minute, before))
+		minutes, err = dbRepo.ListServerStats(ctx, srvCfg.ID, time.Minute, hour, hour.Add(2*time.Hour))
+		assert.NoError(t, err)
+		assert.Len(t, minutes, 1)
+	})
+}
@@ -10,11 +10,26 @@ import (
 	"oppossome/serverpouch/internal/infrastructure/docker"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 func convertToServer(schema *schema.Server) (server.ServerInstanceConfig, error) {
+	meta := server.ServerInstanceMetadata{
+		Name:        schema.Name,
+		Description: schema.Description,
+		Labels:      map[string]string{},
+		CreatedAt:   schema.CreatedAt.Time,
+		UpdatedAt:   schema.UpdatedAt.Time,
+		Revision:    schema.Revision,
+	}
+
+	if err := json.Unmarshal(schema.Labels, &meta.Labels); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal server labels")
+	}
+
 	switch {
 	case schema.Type == string(server.ServerInstanceTypeDocker):
 		var dockerOptions docker.DockerServerInstanceOptions
@@ -23,6 +38,7 @@ func convertToServer(schema *schema.Server) (server.ServerInstanceConfig, error)
 		}
 
 		dockerOptions.InstanceID = schema.ID
+		dockerOptions.Meta = meta
 
 		return &dockerOptions, nil
 	default:
@@ -30,6 +46,15 @@ func convertToServer(schema *schema.Server) (server.ServerInstanceConfig, error)
 	}
 }
 
+// labelsOrEmpty keeps nil labels from being stored as JSON null.
+func labelsOrEmpty(labels map[string]string) map[string]string {
+	if labels == nil {
+		return map[string]string{}
+	}
+
+	return labels
+}
+
 func (d *databaseImpl) GetServer(ctx context.Context, id uuid.UUID) (server.ServerInstanceConfig, error) {
 	dbConfig, err := d.queries.GetServer(ctx, id)
 	if err != nil {
@@ -68,10 +93,23 @@ func (d *databaseImpl) UpdateServer(ctx context.Context, id uuid.UUID, config se
 		return nil, errors.Wrap(err, "failed to convert config to json")
 	}
 
+	labelsJSON, err := json.Marshal(labelsOrEmpty(config.Metadata().Labels))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert labels to json")
+	}
+
+	// The update only applies to the revision it was made against.
 	dbConfig, err := d.queries.UpdateServer(ctx, schema.UpdateServerParams{
-		ID:     id,
-		Config: []byte(configJSON),
+		ID:          id,
+		Config:      []byte(configJSON),
+		Name:        config.Metadata().Name,
+		Description: config.Metadata().Description,
+		Labels:      labelsJSON,
+		Revision:    config.Metadata().Revision,
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(server.ErrRevisionMismatch, "revision %d of server \"%s\"", config.Metadata().Revision, id)
+	}
 	if err != nil {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to update server config")
 		return nil, errors.Wrap(err, "failed to update server config")
@@ -80,6 +118,37 @@ func (d *databaseImpl) UpdateServer(ctx context.Context, id uuid.UUID, config se
 	return convertToServer(&dbConfig)
 }
 
+func (d *databaseImpl) RestoreServer(ctx context.Context, id uuid.UUID, config server.ServerInstanceConfig, revision int64) error {
+	configJSON, err := config.ToJSON()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to convert config to json")
+		return errors.Wrap(err, "failed to convert config to json")
+	}
+
+	labelsJSON, err := json.Marshal(labelsOrEmpty(config.Metadata().Labels))
+	if err != nil {
+		return errors.Wrap(err, "failed to convert labels to json")
+	}
+
+	// Should the server have been updated again since, that update stands.
+	err = d.queries.RestoreServer(ctx, schema.RestoreServerParams{
+		ID:              id,
+		Config:          []byte(configJSON),
+		Name:            config.Metadata().Name,
+		Description:     config.Metadata().Description,
+		Labels:          labelsJSON,
+		Revision:        config.Metadata().Revision,
+		UpdatedAt:       pgtype.Timestamptz{Time: config.Metadata().UpdatedAt, Valid: true},
+		CurrentRevision: revision,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to restore server config")
+		return errors.Wrap(err, "failed to restore server config")
+	}
+
+	return nil
+}
+
 func (d *databaseImpl) CreateServer(ctx context.Context, config server.ServerInstanceConfig) (server.ServerInstanceConfig, error) {
 	configJSON, err := config.ToJSON()
 	if err != nil {
@@ -87,9 +156,17 @@ func (d *databaseImpl) CreateServer(ctx context.Context, config server.ServerIns
 		return nil, errors.Wrap(err, "failed to convert config to json")
 	}
 
+	labelsJSON, err := json.Marshal(labelsOrEmpty(config.Metadata().Labels))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert labels to json")
+	}
+
 	dbConfig, err := d.queries.CreateServer(ctx, schema.CreateServerParams{
-		Type:   string(config.Type()),
-		Config: []byte(configJSON),
+		Type:        string(config.Type()),
+		Config:      []byte(configJSON),
+		Name:        config.Metadata().Name,
+		Description: config.Metadata().Description,
+		Labels:      labelsJSON,
 	})
 	if err != nil {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to create server config")
@@ -98,3 +175,13 @@ func (d *databaseImpl) CreateServer(ctx context.Context, config server.ServerIns
 
 	return convertToServer(&dbConfig)
 }
+
+func (d *databaseImpl) DeleteServer(ctx context.Context, id uuid.UUID) error {
+	err := d.queries.DeleteServer(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to delete server config")
+		return errors.Wrap(err, "failed to delete server config")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package database_test
 import (
 	"testing"
 
+	"oppossome/serverpouch/internal/domain/server"
 	"oppossome/serverpouch/internal/infrastructure/database"
 	"oppossome/serverpouch/internal/infrastructure/database/schema"
 	"oppossome/serverpouch/internal/infrastructure/docker"
@@ -25,9 +26,18 @@ func TestGetServer(t *testing.T) {
 		srvCfg, err := queries.CreateServer(t.Context(), schema.CreateServerParams{
 			Type:   string(cfg.Type()),
 			Config: []byte(cfgJSON),
+			Name:   "test",
+			Labels: []byte(`{"game":"minecraft"}`),
 		})
 		assert.NoError(t, err)
 		cfg.InstanceID = srvCfg.ID // Update cfg to have correct ID
+		cfg.Meta = server.ServerInstanceMetadata{
+			Name:      "test",
+			Labels:    map[string]string{"game": "minecraft"},
+			CreatedAt: srvCfg.CreatedAt.Time,
+			UpdatedAt: srvCfg.UpdatedAt.Time,
+			Revision:  1,
+		}
 
 		dbCfg, err := dbRepo.GetServer(t.Context(), srvCfg.ID)
 		assert.NoError(t, err)
@@ -50,9 +60,18 @@ func TestListServers(t *testing.T) {
 		srvCfg, err := queries.CreateServer(t.Context(), schema.CreateServerParams{
 			Type:   string(cfg.Type()),
 			Config: []byte(cfgJSON),
+			Name:   "test",
+			Labels: []byte(`{"game":"minecraft"}`),
 		})
 		assert.NoError(t, err)
 		cfg.InstanceID = srvCfg.ID
+		cfg.Meta = server.ServerInstanceMetadata{
+			Name:      "test",
+			Labels:    map[string]string{"game": "minecraft"},
+			CreatedAt: srvCfg.CreatedAt.Time,
+			UpdatedAt: srvCfg.UpdatedAt.Time,
+			Revision:  1,
+		}
 
 		dbCfgs, err := dbRepo.ListServers(t.Context())
 		assert.NoError(t, err)
@@ -76,18 +95,97 @@ func TestUpdateServer(t *testing.T) {
 		srvCfg, err := queries.CreateServer(t.Context(), schema.CreateServerParams{
 			Type:   string(cfg.Type()),
 			Config: []byte(cfgJSON),
+			Name:   "test",
+			Labels: []byte(`{"game":"minecraft"}`),
 		})
 		assert.NoError(t, err)
 
 		updatedCfg := &docker.DockerServerInstanceOptions{
 			InstanceID: srvCfg.ID,
-			Image:      "test-image",
+			Meta: server.ServerInstanceMetadata{
+				Name:        "renamed",
+				Description: "Survival world",
+				Labels:      map[string]string{},
+				CreatedAt:   srvCfg.CreatedAt.Time,
+				Revision:    1,
+			},
+			Image: "test-image",
 		}
 
 		dbConfig, err := dbRepo.UpdateServer(t.Context(), srvCfg.ID, updatedCfg)
 		assert.NoError(t, err)
+		assert.False(t, dbConfig.Metadata().UpdatedAt.Before(srvCfg.UpdatedAt.Time))
+
+		updatedCfg.Meta.Revision = 2
+		updatedCfg.Meta.UpdatedAt = dbConfig.Metadata().UpdatedAt
 		assert.Equal(t, updatedCfg, dbConfig)
 	})
+
+	t.Run("Revision Mismatch", func(t *testing.T) {
+		queries, dbRepo := database.NewTestDatabase(t)
+
+		cfg := &docker.DockerServerInstanceOptions{
+			Image: "hello-world",
+		}
+
+		cfgJSON, err := cfg.ToJSON()
+		assert.NoError(t, err)
+
+		srvCfg, err := queries.CreateServer(t.Context(), schema.CreateServerParams{
+			Type:   string(cfg.Type()),
+			Config: []byte(cfgJSON),
+			Name:   "test",
+			Labels: []byte(`{}`),
+		})
+		assert.NoError(t, err)
+
+		// Both updates are made against the first revision, so the second loses.
+		for idx, image := range []string{"first", "second"} {
+			_, err := dbRepo.UpdateServer(t.Context(), srvCfg.ID, &docker.DockerServerInstanceOptions{
+				InstanceID: srvCfg.ID,
+				Meta:       server.ServerInstanceMetadata{Name: "test", Revision: 1},
+				Image:      image,
+			})
+
+			if idx == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, server.ErrRevisionMismatch)
+			}
+		}
+
+		dbCfg, err := dbRepo.GetServer(t.Context(), srvCfg.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "first", dbCfg.ImageName())
+	})
+}
+
+func TestRestoreServer(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		prevCfg, err := dbRepo.CreateServer(t.Context(), &docker.DockerServerInstanceOptions{
+			Meta:  server.ServerInstanceMetadata{Name: "test", Labels: map[string]string{}},
+			Image: "hello-world",
+		})
+		assert.NoError(t, err)
+
+		_, err = dbRepo.UpdateServer(t.Context(), prevCfg.ID(), &docker.DockerServerInstanceOptions{
+			InstanceID: prevCfg.ID(),
+			Meta:       server.ServerInstanceMetadata{Name: "test", Revision: 1},
+			Image:      "updated",
+		})
+		assert.NoError(t, err)
+
+		// The update made revision 2, which is undone entirely.
+		assert.NoError(t, dbRepo.RestoreServer(t.Context(), prevCfg.ID(), prevCfg, 2))
+
+		dbCfg, err := dbRepo.GetServer(t.Context(), prevCfg.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, "hello-world", dbCfg.ImageName())
+		assert.Equal(t, int64(1), dbCfg.Metadata().Revision)
+		assert.True(t, prevCfg.Metadata().UpdatedAt.Equal(dbCfg.Metadata().UpdatedAt))
+	})
 }
 
 func TestCreateServer(t *testing.T) {
@@ -95,6 +193,11 @@ func TestCreateServer(t *testing.T) {
 		_, dbRepo := database.NewTestDatabase(t)
 
 		cfg := &docker.DockerServerInstanceOptions{
+			Meta: server.ServerInstanceMetadata{
+				Name:        "test",
+				Description: "Survival world",
+				Labels:      map[string]string{"game": "minecraft"},
+			},
 			Image: "hello-world",
 		}
 
@@ -102,6 +205,29 @@ func TestCreateServer(t *testing.T) {
 		assert.NoError(t, err)
 
 		cfg.InstanceID = srvCfg.ID() // Update cfg to have correct ID
+		cfg.Meta.CreatedAt = srvCfg.Metadata().CreatedAt
+		cfg.Meta.UpdatedAt = srvCfg.Metadata().UpdatedAt
+		cfg.Meta.Revision = 1
 		assert.Equal(t, cfg, srvCfg)
 	})
 }
+
+func TestDeleteServer(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		cfg := &docker.DockerServerInstanceOptions{
+			Image: "hello-world",
+		}
+
+		srvCfg, err := dbRepo.CreateServer(t.Context(), cfg)
+		assert.NoError(t, err)
+
+		err = dbRepo.DeleteServer(t.Context(), srvCfg.ID())
+		assert.NoError(t, err)
+
+		dbCfgs, err := dbRepo.ListServers(t.Context())
+		assert.NoError(t, err)
+		assert.Empty(t, dbCfgs)
+	})
+}
@@ -0,0 +1,135 @@
+package database
+
+import (
+	"context"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/infrastructure/database/schema"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// uniqueViolation is the Postgres error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+func convertToUser(schema *schema.User, grants []schema.ServerGrant) *auth.User {
+	user := &auth.User{
+		ID:        schema.ID,
+		Name:      schema.Name,
+		IsAdmin:   schema.IsAdmin,
+		CreatedAt: schema.CreatedAt.Time,
+		Grants:    make(map[uuid.UUID]auth.Role),
+	}
+
+	for _, grant := range grants {
+		if grant.UserID == schema.ID {
+			user.Grants[grant.ServerID] = auth.Role(grant.Role)
+		}
+	}
+
+	return user
+}
+
+func (d *databaseImpl) GetUser(ctx context.Context, id uuid.UUID) (*auth.User, error) {
+	dbUser, err := d.queries.GetUser(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(auth.ErrUserNotFound, "user of ID \"%s\"", id)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve user")
+		return nil, errors.Wrap(err, "failed to retrieve user")
+	}
+
+	dbGrants, err := d.queries.GetServerGrants(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve server grants")
+		return nil, errors.Wrap(err, "failed to retrieve server grants")
+	}
+
+	return convertToUser(&dbUser, dbGrants), nil
+}
+
+func (d *databaseImpl) ListUsers(ctx context.Context) ([]*auth.User, error) {
+	dbUsers, err := d.queries.GetUsers(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve users")
+		return nil, errors.Wrap(err, "failed to retrieve users")
+	}
+
+	dbGrants, err := d.queries.GetAllServerGrants(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to retrieve server grants")
+		return nil, errors.Wrap(err, "failed to retrieve server grants")
+	}
+
+	users := make([]*auth.User, len(dbUsers))
+	for idx, dbUser := range dbUsers {
+		users[idx] = convertToUser(&dbUser, dbGrants)
+	}
+
+	return users, nil
+}
+
+func (d *databaseImpl) CreateUser(ctx context.Context, name string, isAdmin bool) (*auth.User, error) {
+	dbUser, err := d.queries.CreateUser(ctx, schema.CreateUserParams{
+		Name:    name,
+		IsAdmin: isAdmin,
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, errors.Wrapf(auth.ErrUserExists, "user named \"%s\"", name)
+	}
+
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to create user")
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+
+	return convertToUser(&dbUser, nil), nil
+}
+
+func (d *databaseImpl) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	rows, err := d.queries.DeleteUser(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to delete user")
+		return errors.Wrap(err, "failed to delete user")
+	}
+
+	if rows == 0 {
+		return errors.Wrapf(auth.ErrUserNotFound, "user of ID \"%s\"", id)
+	}
+
+	return nil
+}
+
+func (d *databaseImpl) SetServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID, role auth.Role) error {
+	err := d.queries.SetServerGrant(ctx, schema.SetServerGrantParams{
+		UserID:   userID,
+		ServerID: serverID,
+		Role:     string(role),
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to set server grant")
+		return errors.Wrap(err, "failed to set server grant")
+	}
+
+	return nil
+}
+
+func (d *databaseImpl) DeleteServerGrant(ctx context.Context, userID uuid.UUID, serverID uuid.UUID) error {
+	_, err := d.queries.DeleteServerGrant(ctx, schema.DeleteServerGrantParams{
+		UserID:   userID,
+		ServerID: serverID,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to delete server grant")
+		return errors.Wrap(err, "failed to delete server grant")
+	}
+
+	return nil
+}
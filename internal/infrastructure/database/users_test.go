@@ -0,0 +1,74 @@
+package database_test
+
+import (
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/auth"
+	"oppossome/serverpouch/internal/infrastructure/database"
+	"oppossome/serverpouch/internal/infrastructure/database/schema"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateUser(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		user, err := dbRepo.CreateUser(t.Context(), "test", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", user.Name)
+		assert.True(t, user.IsAdmin)
+	})
+
+	t.Run("Duplicate name", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		_, err := dbRepo.CreateUser(t.Context(), "test", false)
+		assert.NoError(t, err)
+
+		_, err = dbRepo.CreateUser(t.Context(), "test", false)
+		assert.True(t, errors.Is(err, auth.ErrUserExists))
+	})
+}
+
+func TestServerGrants(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		queries, dbRepo := database.NewTestDatabase(t)
+
+		srvCfg, err := queries.CreateServer(t.Context(), schema.CreateServerParams{
+			Type:   "docker",
+			Config: []byte(`{"image":"hello-world"}`),
+			Name:   "test",
+			Labels: []byte(`{}`),
+		})
+		assert.NoError(t, err)
+
+		user, err := dbRepo.CreateUser(t.Context(), "test", false)
+		assert.NoError(t, err)
+
+		assert.NoError(t, dbRepo.SetServerGrant(t.Context(), user.ID, srvCfg.ID, auth.RoleViewer))
+		assert.NoError(t, dbRepo.SetServerGrant(t.Context(), user.ID, srvCfg.ID, auth.RoleModerator))
+
+		dbUser, err := dbRepo.GetUser(t.Context(), user.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]auth.Role{srvCfg.ID: auth.RoleModerator}, dbUser.Grants)
+
+		// Grants go away along with their server
+		assert.NoError(t, dbRepo.DeleteServer(t.Context(), srvCfg.ID))
+
+		dbUser, err = dbRepo.GetUser(t.Context(), user.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, dbUser.Grants)
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	t.Run("Not Found", func(t *testing.T) {
+		_, dbRepo := database.NewTestDatabase(t)
+
+		err := dbRepo.DeleteUser(t.Context(), uuid.New())
+		assert.True(t, errors.Is(err, auth.ErrUserNotFound))
+	})
+}
@@ -2,40 +2,58 @@ package docker
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	"oppossome/serverpouch/internal/domain/server"
 
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 // MARK: Start
 
-func (dsi *dockerServerInstance) Start() error {
+func (dsi *dockerServerInstance) Start() (err error) {
+	defer observeAction("start", time.Now(), &err)
+
 	actionDone, err := dsi.lifecycleAction(dsi.ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to acquire start action")
 	}
 	defer actionDone()
 
-	status := dsi.Status()
-	if status != server.ServerInstanceStatusIdle {
-		msg := fmt.Sprintf("Start is an invalid action for status %s", status)
-		dsi.events.TerminalOut.Dispatch(msg)
-		return errors.New(msg)
-	}
-
 	dsi.mu.RLock()
 	containerID := dsi.containerID
 	dsi.mu.RUnlock()
 
+	status := dsi.Status()
+	switch {
+	case status == server.ServerInstanceStatusIdle, status == server.ServerInstanceStatusCrashlooping:
+	// Having given up on restarting it, the container's still there to start.
+	case status == server.ServerInstanceStatusErrored && containerID != "":
+	default:
+		err := &server.InvalidStatusError{Action: "Start", Status: status}
+		dsi.events.TerminalOut.Dispatch(err.Error())
+		return err
+	}
+
+	// Starting by hand gives the restart policy a fresh set of retries.
+	dsi.mu.Lock()
+	dsi.restartAttempts = 0
+	dsi.mu.Unlock()
+
 	dsi.setStatus(server.ServerInstanceStatusStarting)
+	dsi.lifecycleAttach(containerID)
 
 	err = dsi.client.ContainerStart(dsi.ctx, containerID, container.StartOptions{})
 	if err != nil {
 		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to start container: %s", err)
 		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to start container: %s", err))
+		failAction("start")
 	}
 
 	return nil
@@ -43,7 +61,9 @@ func (dsi *dockerServerInstance) Start() error {
 
 // MARK: Stop
 
-func (dsi *dockerServerInstance) Stop() error {
+func (dsi *dockerServerInstance) Stop() (err error) {
+	defer observeAction("stop", time.Now(), &err)
+
 	actionDone, err := dsi.lifecycleAction(dsi.ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to acquire stop action")
@@ -51,30 +71,54 @@ func (dsi *dockerServerInstance) Stop() error {
 	defer actionDone()
 
 	status := dsi.Status()
-	if status != server.ServerInstanceStatusRunning {
-		msg := fmt.Sprintf("Stop is an invalid action for status %s", status)
-		dsi.events.TerminalOut.Dispatch(msg)
-		return errors.New(msg)
+
+	// The container has already exited, so all that's left is to not restart it.
+	if status == server.ServerInstanceStatusCrashlooping {
+		dsi.setStatus(server.ServerInstanceStatusIdle)
+		return nil
 	}
 
 	dsi.mu.RLock()
 	containerID := dsi.containerID
 	dsi.mu.RUnlock()
 
+	if !stoppable(status, containerID) {
+		err := &server.InvalidStatusError{Action: "Stop", Status: status}
+		dsi.events.TerminalOut.Dispatch(err.Error())
+		return err
+	}
+
 	dsi.setStatus(server.ServerInstanceStatusStopping)
 
 	err = dsi.client.ContainerStop(dsi.ctx, containerID, container.StopOptions{})
 	if err != nil {
 		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to stop container: %s", err)
 		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to stop container: %s", err))
+		failAction("stop")
 	}
 
 	return nil
 }
 
+// stoppable reports whether the container may be running, so can be stopped.
+// Starting servers are included, since they stay starting until their
+// readiness probe passes.
+func stoppable(status server.ServerInstanceStatus, containerID string) bool {
+	switch status {
+	case server.ServerInstanceStatusRunning, server.ServerInstanceStatusStarting:
+		return true
+	case server.ServerInstanceStatusErrored:
+		return containerID != ""
+	default:
+		return false
+	}
+}
+
 // MARK: Kill
 
-func (dsi *dockerServerInstance) Kill() error {
+func (dsi *dockerServerInstance) Kill() (err error) {
+	defer observeAction("kill", time.Now(), &err)
+
 	actionDone, err := dsi.lifecycleAction(dsi.ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to acquire kill action")
@@ -82,22 +126,247 @@ func (dsi *dockerServerInstance) Kill() error {
 	defer actionDone()
 
 	status := dsi.Status()
-	if status != server.ServerInstanceStatusRunning {
-		msg := fmt.Sprintf("Kill is an invalid action for status %s", status)
-		dsi.events.TerminalOut.Dispatch(msg)
-		return errors.New(msg)
-	}
-
 	dsi.mu.RLock()
 	containerID := dsi.containerID
 	dsi.mu.RUnlock()
 
+	if !stoppable(status, containerID) {
+		err := &server.InvalidStatusError{Action: "Kill", Status: status}
+		dsi.events.TerminalOut.Dispatch(err.Error())
+		return err
+	}
+
 	dsi.setStatus(server.ServerInstanceStatusStopping)
 
 	err = dsi.client.ContainerKill(dsi.ctx, containerID, "SIGKILL")
 	if err != nil {
 		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to kill container: %s", err)
 		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to kill container: %s", err))
+		failAction("kill")
+	}
+
+	return nil
+}
+
+// MARK: Restart
+
+func (dsi *dockerServerInstance) Restart() (err error) {
+	defer observeAction("restart", time.Now(), &err)
+
+	actionDone, err := dsi.lifecycleAction(dsi.ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to acquire restart action")
+	}
+	defer actionDone()
+
+	status := dsi.Status()
+	dsi.mu.RLock()
+	containerID := dsi.containerID
+	dsi.mu.RUnlock()
+
+	if !stoppable(status, containerID) {
+		err := &server.InvalidStatusError{Action: "Restart", Status: status}
+		dsi.events.TerminalOut.Dispatch(err.Error())
+		return err
+	}
+
+	// Both halves happen within the same action so nothing can slip in between.
+	dsi.setStatus(server.ServerInstanceStatusStopping)
+
+	err = dsi.client.ContainerStop(dsi.ctx, containerID, container.StopOptions{})
+	if err != nil {
+		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to stop container: %s", err)
+		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to stop container: %s", err))
+		failAction("restart")
+		return nil
+	}
+
+	dsi.setStatus(server.ServerInstanceStatusStarting)
+	dsi.lifecycleAttach(containerID)
+
+	err = dsi.client.ContainerStart(dsi.ctx, containerID, container.StartOptions{})
+	if err != nil {
+		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to start container: %s", err)
+		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to start container: %s", err))
+		failAction("restart")
+	}
+
+	return nil
+}
+
+// MARK: Update
+
+func (dsi *dockerServerInstance) Update(config server.ServerInstanceConfig) (err error) {
+	defer observeAction("update", time.Now(), &err)
+
+	options, ok := config.(*DockerServerInstanceOptions)
+	if !ok {
+		return errors.Errorf("unable to apply a %s config to a docker instance", config.Type())
+	}
+
+	actionDone, err := dsi.lifecycleAction(dsi.ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to acquire update action")
+	}
+	defer actionDone()
+
+	// Look the container up by name rather than trusting our own state, as it
+	// may exist even if we previously failed to initialize.
+	existing, err := dsi.lifecycleFindContainer(dsi.ctx)
+	if err != nil {
+		return err
+	}
+
+	// Resource limits can be changed on the container as it is, even while
+	// it's running. Should docker refuse, we recreate it like any other change.
+	if existing != nil && dsi.options.updatableTo(options) {
+		_, err = dsi.client.ContainerUpdate(dsi.ctx, existing.ID, container.UpdateConfig{
+			Resources: options.Resources.toResources(),
+		})
+		if err == nil {
+			dsi.mu.Lock()
+			dsi.options = options
+			dsi.mu.Unlock()
+
+			zerolog.Ctx(dsi.ctx).Info().Msgf("Updated container \"%s\"", existing.ID)
+			return nil
+		}
+
+		zerolog.Ctx(dsi.ctx).Warn().Msgf("Unable to update container, recreating it: %s", err)
+	}
+
+	return dsi.lifecycleRecreate(existing, options, "update")
+}
+
+// lifecycleRecreate replaces the existing container, if any, with one created
+// from options, starting it again if it was running. Volumes and bind mounts
+// are left untouched, so the server keeps its data.
+func (dsi *dockerServerInstance) lifecycleRecreate(existing *types.Container, options *DockerServerInstanceOptions, action string) error {
+	wasRunning := existing != nil && existing.State == "running"
+	if wasRunning {
+		dsi.setStatus(server.ServerInstanceStatusStopping)
+
+		err := dsi.client.ContainerStop(dsi.ctx, existing.ID, container.StopOptions{})
+		if err != nil {
+			zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to stop container: %s", err)
+			return errors.Wrap(err, "Unable to stop container")
+		}
+	}
+
+	// The container's configuration is immutable, so it has to be recreated.
+	if existing != nil {
+		err := dsi.client.ContainerRemove(dsi.ctx, existing.ID, container.RemoveOptions{})
+		if err != nil {
+			zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to remove container: %s", err)
+			return errors.Wrap(err, "Unable to remove container")
+		}
+
+		zerolog.Ctx(dsi.ctx).Info().Msgf("Removed container \"%s\"", existing.ID)
+	}
+
+	dsi.mu.Lock()
+	previous := dsi.options
+	dsi.options = options
+	dsi.containerID = ""
+	dsi.mu.Unlock()
+
+	// Failing leaves the previous options in place, so that they still match
+	// what's stored and the update can be retried.
+	containerID, err := dsi.lifecycleCreateContainer(dsi.ctx)
+	if err != nil {
+		dsi.mu.Lock()
+		dsi.options = previous
+		dsi.mu.Unlock()
+
+		dsi.setErrored(fmt.Sprintf("Unable to recreate container: %s", err))
+		return err
+	}
+
+	dsi.mu.Lock()
+	dsi.containerID = containerID
+	dsi.mu.Unlock()
+
+	if !wasRunning {
+		return nil
+	}
+
+	// Restore the container to its previous running state.
+	dsi.setStatus(server.ServerInstanceStatusStarting)
+	dsi.lifecycleAttach(containerID)
+
+	err = dsi.client.ContainerStart(dsi.ctx, containerID, container.StartOptions{})
+	if err != nil {
+		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to start container: %s", err)
+		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to start container: %s", err))
+		failAction(action)
+	}
+
+	return nil
+}
+
+// MARK: Remove
+
+func (dsi *dockerServerInstance) Remove(purgeData bool) (err error) {
+	defer observeAction("remove", time.Now(), &err)
+
+	actionDone, err := dsi.lifecycleAction(dsi.ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to acquire remove action")
+	}
+	defer actionDone()
+
+	existing, err := dsi.lifecycleFindContainer(dsi.ctx)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		err = dsi.client.ContainerRemove(dsi.ctx, existing.ID, container.RemoveOptions{
+			RemoveVolumes: purgeData,
+			Force:         true,
+		})
+		if err != nil {
+			zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to remove container: %s", err)
+			return errors.Wrap(err, "Unable to remove container")
+		}
+
+		zerolog.Ctx(dsi.ctx).Info().Msgf("Removed container \"%s\"", existing.ID)
+	}
+
+	dsi.mu.Lock()
+	dsi.containerID = ""
+	dsi.mu.Unlock()
+
+	if !purgeData {
+		return nil
+	}
+
+	for source := range dsi.options.ContainerVolumes {
+		// Anything that isn't a path is a named volume managed by docker.
+		if !filepath.IsAbs(source) {
+			err = dsi.client.VolumeRemove(dsi.ctx, source, true)
+			switch {
+			case client.IsErrNotFound(err):
+			case err != nil:
+				zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to remove volume \"%s\": %s", source, err)
+				return errors.Wrapf(err, "Unable to remove volume \"%s\"", source)
+			default:
+				zerolog.Ctx(dsi.ctx).Info().Msgf("Removed volume \"%s\"", source)
+			}
+
+			continue
+		}
+
+		if filepath.Clean(source) == "/" {
+			return errors.New("Refusing to remove the root directory")
+		}
+
+		if err := os.RemoveAll(source); err != nil {
+			zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to remove bind mount \"%s\": %s", source, err)
+			return errors.Wrapf(err, "Unable to remove bind mount \"%s\"", source)
+		}
+
+		zerolog.Ctx(dsi.ctx).Info().Msgf("Removed bind mount \"%s\"", source)
 	}
 
 	return nil
@@ -0,0 +1,475 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/network"
+	"github.com/google/uuid"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MARK: - Restart
+
+func TestRestart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Stops and starts within one action", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusRunning
+
+		mockClient.EXPECT().ContainerStop(
+			dsi.ctx,
+			dsi.containerID,
+			container.StopOptions{},
+		).Return(nil).Once()
+
+		attach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			dsi.containerID,
+			mock.Anything,
+		).Return(attach, nil).Once()
+
+		mockClient.EXPECT().ContainerStart(
+			dsi.ctx,
+			dsi.containerID,
+			container.StartOptions{},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+				Mounts:          []types.MountPoint{},
+				Config:          &container.Config{},
+				NetworkSettings: &types.NetworkSettings{},
+			},
+			nil,
+		).Once()
+
+		statusChan := dsi.events.Status.On()
+		defer dsi.Events().Status.Off(statusChan)
+
+		go dsi.lifecycle()
+		go func() {
+			assert.NoError(t, dsi.Restart())
+		}()
+
+		assert.Equal(t, server.ServerInstanceStatusStopping, <-statusChan)
+		assert.Equal(t, server.ServerInstanceStatusStarting, <-statusChan)
+		assert.Equal(t, server.ServerInstanceStatusRunning, <-statusChan)
+	})
+}
+
+// MARK: - Update
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Recreates a running container", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusRunning
+
+		updated := &DockerServerInstanceOptions{
+			InstanceID:     dsi.options.InstanceID,
+			Image:          "Test",
+			ContainerPorts: map[int]string{25565: "25565/tcp"},
+			ContainerEnv:   []string{"EULA=true"},
+		}
+
+		// First, it finds the running container
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:    dsi.containerID,
+				Image: dsi.options.Image,
+				State: "running",
+				Names: []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		// Second, it stops and removes it
+		mockClient.EXPECT().ContainerStop(
+			dsi.ctx,
+			dsi.containerID,
+			container.StopOptions{},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().ContainerRemove(
+			dsi.ctx,
+			dsi.containerID,
+			container.RemoveOptions{},
+		).Return(nil).Once()
+
+		// Third, it recreates the container from the updated options
+		mockClient.EXPECT().ImageList(
+			dsi.ctx,
+			image.ListOptions{All: true},
+		).Return(
+			[]image.Summary{{
+				Labels: map[string]string{
+					"org.opencontainers.image.ref.name": updated.Image,
+				},
+			}},
+			nil,
+		).Once()
+
+		opts, hostOpts := updated.toOptions()
+		mockClient.EXPECT().ContainerCreate(
+			dsi.ctx,
+			opts,
+			hostOpts,
+			(*network.NetworkingConfig)(nil),
+			(*v1.Platform)(nil),
+			updated.InstanceID.String(),
+		).Return(
+			container.CreateResponse{ID: "recreated"},
+			nil,
+		).Once()
+
+		// Finally, it starts the new container since the old one was running
+		attach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			"recreated",
+			mock.Anything,
+		).Return(attach, nil).Once()
+
+		mockClient.EXPECT().ContainerStart(
+			dsi.ctx,
+			"recreated",
+			container.StartOptions{},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			"recreated",
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+				Mounts:          []types.MountPoint{},
+				Config:          &container.Config{},
+				NetworkSettings: &types.NetworkSettings{},
+			},
+			nil,
+		).Once()
+
+		statusChan := dsi.events.Status.On()
+		defer dsi.Events().Status.Off(statusChan)
+
+		go dsi.lifecycle()
+		go func() {
+			assert.NoError(t, dsi.Update(updated))
+		}()
+
+		assert.Equal(t, server.ServerInstanceStatusStopping, <-statusChan)
+		assert.Equal(t, server.ServerInstanceStatusStarting, <-statusChan)
+		assert.Equal(t, server.ServerInstanceStatusRunning, <-statusChan)
+		assert.Equal(t, updated, dsi.Config())
+		assert.Equal(t, "recreated", dsi.containerID)
+	})
+
+	t.Run("Ok - Applies resource limits live", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+			Resources:  DockerResources{Memory: 512 << 20},
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusRunning
+
+		updated := &DockerServerInstanceOptions{
+			InstanceID: dsi.options.InstanceID,
+			Image:      "Test",
+			Resources:  DockerResources{Memory: 1 << 30, CPUs: 1.5, PIDsLimit: 256},
+		}
+
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:    dsi.containerID,
+				Image: dsi.options.Image,
+				State: "running",
+				Names: []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		// The container is updated in place rather than stopped and recreated
+		pidsLimit := int64(256)
+		mockClient.EXPECT().ContainerUpdate(
+			dsi.ctx,
+			dsi.containerID,
+			container.UpdateConfig{Resources: container.Resources{
+				Memory:    1 << 30,
+				NanoCPUs:  1_500_000_000,
+				PidsLimit: &pidsLimit,
+			}},
+		).Return(container.ContainerUpdateOKBody{}, nil).Once()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+				Mounts:          []types.MountPoint{},
+				Config:          &container.Config{},
+				NetworkSettings: &types.NetworkSettings{},
+			},
+			nil,
+		).Once()
+
+		go dsi.lifecycle()
+		assert.NoError(t, dsi.Update(updated))
+		assert.Equal(t, server.ServerInstanceStatusRunning, dsi.Status())
+		assert.Equal(t, updated, dsi.Config())
+		assert.Equal(t, uuid.Nil.String(), dsi.containerID)
+	})
+
+	t.Run("Ok - Recreates an errored instance's container", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Missing",
+		})
+
+		dsi.status = server.ServerInstanceStatusErrored
+
+		updated := &DockerServerInstanceOptions{
+			InstanceID: dsi.options.InstanceID,
+			Image:      "Test",
+		}
+
+		// There's no container since the image couldn't be found
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{},
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ImageList(
+			dsi.ctx,
+			image.ListOptions{All: true},
+		).Return(
+			[]image.Summary{{
+				Labels: map[string]string{
+					"org.opencontainers.image.ref.name": updated.Image,
+				},
+			}},
+			nil,
+		).Once()
+
+		opts, hostOpts := updated.toOptions()
+		mockClient.EXPECT().ContainerCreate(
+			dsi.ctx,
+			opts,
+			hostOpts,
+			(*network.NetworkingConfig)(nil),
+			(*v1.Platform)(nil),
+			updated.InstanceID.String(),
+		).Return(
+			container.CreateResponse{ID: "recreated"},
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			"recreated",
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "created"},
+				},
+				Mounts:          []types.MountPoint{},
+				Config:          &container.Config{},
+				NetworkSettings: &types.NetworkSettings{},
+			},
+			nil,
+		).Once()
+
+		go dsi.lifecycle()
+		assert.NoError(t, dsi.Update(updated))
+		assert.Equal(t, server.ServerInstanceStatusIdle, dsi.Status())
+	})
+}
+
+// MARK: - Remove
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Keeps data by default", func(t *testing.T) {
+		dataDir := t.TempDir()
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID:       uuid.New(),
+			Image:            "Test",
+			ContainerVolumes: map[string]string{dataDir: "/data", "named": "/named"},
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusRunning
+
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:    dsi.containerID,
+				Names: []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ContainerRemove(
+			dsi.ctx,
+			dsi.containerID,
+			container.RemoveOptions{Force: true},
+		).Return(nil).Once()
+
+		go dsi.lifecycle()
+		assert.NoError(t, dsi.Remove(false))
+		assert.DirExists(t, dataDir)
+	})
+
+	t.Run("Ok - Purges volumes and bind mounts", func(t *testing.T) {
+		dataDir := filepath.Join(t.TempDir(), "data")
+		assert.NoError(t, os.MkdirAll(filepath.Join(dataDir, "world"), 0o755))
+
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID:       uuid.New(),
+			Image:            "Test",
+			ContainerVolumes: map[string]string{dataDir: "/data", "named": "/named"},
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusIdle
+
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:    dsi.containerID,
+				Names: []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ContainerRemove(
+			dsi.ctx,
+			dsi.containerID,
+			container.RemoveOptions{RemoveVolumes: true, Force: true},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().VolumeRemove(
+			dsi.ctx,
+			"named",
+			true,
+		).Return(nil).Once()
+
+		go dsi.lifecycle()
+		assert.NoError(t, dsi.Remove(true))
+		assert.NoDirExists(t, dataDir)
+	})
+}
+
+// MARK: - Invalid Status
+
+func TestInvalidStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status server.ServerInstanceStatus
+		action func(*dockerServerInstance) error
+	}{
+		{
+			name:   "Start",
+			status: server.ServerInstanceStatusRunning,
+			action: (*dockerServerInstance).Start,
+		},
+		{
+			name:   "Stop",
+			status: server.ServerInstanceStatusIdle,
+			action: (*dockerServerInstance).Stop,
+		},
+		{
+			name:   "Kill",
+			status: server.ServerInstanceStatusIdle,
+			action: (*dockerServerInstance).Kill,
+		},
+		{
+			name:   "Restart",
+			status: server.ServerInstanceStatusIdle,
+			action: (*dockerServerInstance).Restart,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+				InstanceID: uuid.New(),
+				Image:      "Test",
+			})
+
+			dsi.containerID = uuid.Nil.String()
+			dsi.status = tt.status
+
+			// The action still refreshes the status once it completes.
+			mockClient.EXPECT().ContainerInspect(
+				dsi.ctx,
+				dsi.containerID,
+			).Return(
+				types.ContainerJSON{
+					ContainerJSONBase: &types.ContainerJSONBase{
+						State: &types.ContainerState{Status: "exited"},
+					},
+					Mounts:          []types.MountPoint{},
+					Config:          &container.Config{},
+					NetworkSettings: &types.NetworkSettings{},
+				},
+				nil,
+			).Once()
+
+			go dsi.lifecycle()
+
+			err := tt.action(dsi)
+			assert.Equal(t, &server.InvalidStatusError{Action: tt.name, Status: tt.status}, err)
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"slices"
 
 	"oppossome/serverpouch/internal/domain/server"
 
@@ -16,11 +19,69 @@ import (
 var _ server.ServerInstanceConfig = (*DockerServerInstanceOptions)(nil)
 
 type DockerServerInstanceOptions struct {
-	InstanceID       uuid.UUID
+	InstanceID uuid.UUID
+	// Meta is stored alongside the config rather than in it.
+	Meta server.ServerInstanceMetadata `json:"-"`
+
 	Image            string            `json:"image"`
 	ContainerVolumes map[string]string `json:"volumes"`
 	ContainerPorts   map[int]string    `json:"ports"`
 	ContainerEnv     []string          `json:"env"`
+	Resources        DockerResources   `json:"resources"`
+	// RestartPolicy is enforced by the instance rather than docker, so that it
+	// knows when the server is being restarted.
+	RestartPolicy  server.RestartPolicy   `json:"restartPolicy"`
+	ReadinessProbe *server.ReadinessProbe `json:"readinessProbe,omitempty"`
+}
+
+// DockerResources limits what a container can use of the host. Zero values
+// leave the resource unlimited.
+type DockerResources struct {
+	// Memory is the hard memory limit in bytes.
+	Memory int64 `json:"memory,omitempty"`
+	// MemoryReservation is the soft memory limit in bytes, enforced when the
+	// host is low on memory.
+	MemoryReservation int64 `json:"memoryReservation,omitempty"`
+	// MemorySwap is the limit on memory plus swap in bytes, or -1 for
+	// unlimited swap.
+	MemorySwap int64 `json:"memorySwap,omitempty"`
+	// CPUShares weighs the container against others when the CPU is contended.
+	CPUShares int64 `json:"cpuShares,omitempty"`
+	// CPUs is the CPU quota, as a number of CPUs.
+	CPUs float64 `json:"cpus,omitempty"`
+	// CPUSet lists the CPUs the container may run on, such as 0-3 or 0,2.
+	CPUSet    string `json:"cpuset,omitempty"`
+	PIDsLimit int64  `json:"pidsLimit,omitempty"`
+}
+
+func (dr DockerResources) toResources() container.Resources {
+	resources := container.Resources{
+		Memory:            dr.Memory,
+		MemoryReservation: dr.MemoryReservation,
+		MemorySwap:        dr.MemorySwap,
+		CPUShares:         dr.CPUShares,
+		NanoCPUs:          int64(math.Round(dr.CPUs * 1e9)),
+		CpusetCpus:        dr.CPUSet,
+	}
+
+	if dr.PIDsLimit != 0 {
+		resources.PidsLimit = &dr.PIDsLimit
+	}
+
+	return resources
+}
+
+// liftsLimits reports whether going from dr to other removes any limit.
+// Docker treats unset values as unchanged when updating a container, so a
+// limit can only be removed by recreating it.
+func (dr DockerResources) liftsLimits(other DockerResources) bool {
+	return (dr.Memory != 0 && other.Memory == 0) ||
+		(dr.MemoryReservation != 0 && other.MemoryReservation == 0) ||
+		(dr.MemorySwap != 0 && other.MemorySwap == 0) ||
+		(dr.CPUShares != 0 && other.CPUShares == 0) ||
+		(dr.CPUs != 0 && other.CPUs == 0) ||
+		(dr.CPUSet != "" && other.CPUSet == "") ||
+		(dr.PIDsLimit != 0 && other.PIDsLimit == 0)
 }
 
 func (dsic *DockerServerInstanceOptions) toOptions() (*container.Config, *container.HostConfig) {
@@ -29,11 +90,13 @@ func (dsic *DockerServerInstanceOptions) toOptions() (*container.Config, *contai
 		ExposedPorts: nat.PortSet{},
 		Volumes:      map[string]struct{}{},
 		Env:          dsic.ContainerEnv,
+		Labels:       map[string]string{instanceLabel: dsic.InstanceID.String()},
 	}
 
 	hostConfig := container.HostConfig{
 		PortBindings: nat.PortMap{},
 		Binds:        []string{},
+		Resources:    dsic.Resources.toResources(),
 	}
 
 	for hostPort, containerPort := range dsic.ContainerPorts {
@@ -55,6 +118,30 @@ func (dsic *DockerServerInstanceOptions) toOptions() (*container.Config, *contai
 	return &config, &hostConfig
 }
 
+// updatableTo reports whether the container created from these options can be
+// brought in line with other by updating its resources, rather than having to
+// be recreated.
+func (dsio *DockerServerInstanceOptions) updatableTo(other *DockerServerInstanceOptions) bool {
+	return dsio.Image == other.Image &&
+		maps.Equal(dsio.ContainerVolumes, other.ContainerVolumes) &&
+		maps.Equal(dsio.ContainerPorts, other.ContainerPorts) &&
+		slices.Equal(dsio.ContainerEnv, other.ContainerEnv) &&
+		!dsio.Resources.liftsLimits(other.Resources)
+}
+
+// HostAccessEqual compares the image, the sources and targets of the volumes,
+// and the ports, as those are what reach into the host.
+func (dsio *DockerServerInstanceOptions) HostAccessEqual(other server.ServerInstanceConfig) bool {
+	otherOptions, ok := other.(*DockerServerInstanceOptions)
+	if !ok {
+		return false
+	}
+
+	return dsio.Image == otherOptions.Image &&
+		maps.Equal(dsio.ContainerVolumes, otherOptions.ContainerVolumes) &&
+		maps.Equal(dsio.ContainerPorts, otherOptions.ContainerPorts)
+}
+
 func (dsio *DockerServerInstanceOptions) ID() uuid.UUID {
 	return dsio.InstanceID
 }
@@ -63,6 +150,14 @@ func (dsio *DockerServerInstanceOptions) Type() server.ServerInstanceType {
 	return server.ServerInstanceTypeDocker
 }
 
+func (dsio *DockerServerInstanceOptions) Metadata() *server.ServerInstanceMetadata {
+	return &dsio.Meta
+}
+
+func (dsio *DockerServerInstanceOptions) ImageName() string {
+	return dsio.Image
+}
+
 func (dsio *DockerServerInstanceOptions) Ports() []int {
 	ports := []int{}
 	for hostPort := range dsio.ContainerPorts {
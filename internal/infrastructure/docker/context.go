@@ -6,10 +6,17 @@ import (
 	"github.com/docker/docker/client"
 )
 
-var dockerClientKey = &struct{ name string }{"dockerClient"}
+var (
+	dockerClientKey = &struct{ name string }{"dockerClient"}
+	eventRouterKey  = &struct{ name string }{"eventRouter"}
+	probeHostKey    = &struct{ name string }{"probeHost"}
+)
 
+// WithClient provides the client to the instances created with ctx, alongside
+// a single subscription to its events for them to share.
 func WithClient(ctx context.Context, cl client.APIClient) context.Context {
-	return context.WithValue(ctx, dockerClientKey, cl)
+	ctx = context.WithValue(ctx, dockerClientKey, cl)
+	return context.WithValue(ctx, eventRouterKey, newEventRouter(ctx, cl))
 }
 
 func ClientFromContext(ctx context.Context) client.APIClient {
@@ -20,3 +27,28 @@ func ClientFromContext(ctx context.Context) client.APIClient {
 
 	return cl
 }
+
+func eventRouterFromContext(ctx context.Context) *eventRouter {
+	er, ok := ctx.Value(eventRouterKey).(*eventRouter)
+	if !ok {
+		panic("Event router not found in context!")
+	}
+
+	return er
+}
+
+// WithProbeHost sets where TCP and HTTP probes connect to the servers' host
+// ports, for when they aren't published on our own loopback, such as when
+// we're running within a container ourselves.
+func WithProbeHost(ctx context.Context, host string) context.Context {
+	return context.WithValue(ctx, probeHostKey, host)
+}
+
+func probeHostFromContext(ctx context.Context) string {
+	host, ok := ctx.Value(probeHostKey).(string)
+	if !ok {
+		return defaultProbeHost
+	}
+
+	return host
+}
@@ -0,0 +1,229 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog"
+)
+
+// instanceLabel marks the containers we manage with the ID of their instance.
+const instanceLabel = "serverpouch.id"
+
+const (
+	// Reconnecting to docker backs off exponentially from eventsBackoffMin to
+	// eventsBackoffMax.
+	eventsBackoffMin = time.Second
+	eventsBackoffMax = 30 * time.Second
+
+	// instanceEventsBuffer is how many events an instance can fall behind by
+	// before further events are dropped. Any event refreshes the status, so
+	// only the first few matter.
+	instanceEventsBuffer = 16
+)
+
+// MARK: eventRouter
+
+// eventRouter shares a single subscription to docker's events between every
+// instance, passing each event on to the instance its container belongs to.
+// It's subscribed for as long as there are instances.
+type eventRouter struct {
+	ctx    context.Context
+	client client.APIClient
+
+	mu        sync.Mutex
+	instances map[string]*dockerServerInstance
+	cancel    context.CancelFunc
+}
+
+func newEventRouter(ctx context.Context, cl client.APIClient) *eventRouter {
+	return &eventRouter{
+		ctx:       context.WithoutCancel(ctx),
+		client:    cl,
+		instances: map[string]*dockerServerInstance{},
+	}
+}
+
+func (er *eventRouter) add(dsi *dockerServerInstance) {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	er.instances[dsi.options.ID().String()] = dsi
+
+	if er.cancel == nil {
+		ctx, ctxCancel := context.WithCancel(er.ctx)
+		er.cancel = ctxCancel
+		go er.watch(ctx)
+	}
+}
+
+func (er *eventRouter) remove(dsi *dockerServerInstance) {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	id := dsi.options.ID().String()
+	if er.instances[id] == dsi {
+		delete(er.instances, id)
+	}
+
+	if len(er.instances) == 0 && er.cancel != nil {
+		er.cancel()
+		er.cancel = nil
+	}
+}
+
+// watch subscribes to docker's events until ctx is done, reconnecting
+// whenever the subscription is lost, such as when docker restarts.
+func (er *eventRouter) watch(ctx context.Context) {
+	backoff := eventsBackoffMin
+	reconnecting := false
+
+	for {
+		msgs, errs := er.client.Events(ctx, events.ListOptions{
+			Filters: filters.NewArgs(
+				filters.Arg("type", string(events.ContainerEventType)),
+				filters.Arg("label", instanceLabel),
+				filters.Arg("event", string(events.ActionStart)),
+				filters.Arg("event", string(events.ActionDie)),
+				filters.Arg("event", string(events.ActionOOM)),
+				filters.Arg("event", string(events.ActionHealthStatus)),
+				filters.Arg("event", string(events.ActionDestroy)),
+			),
+		})
+
+		// Events may have been missed while we weren't subscribed.
+		if reconnecting {
+			er.reconcile()
+		}
+
+		subscribed := time.Now()
+		err := er.route(ctx, msgs, errs)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Only back off further if the subscription didn't last.
+		if time.Since(subscribed) > eventsBackoffMax {
+			backoff = eventsBackoffMin
+		}
+
+		zerolog.Ctx(ctx).Warn().Err(err).Msgf("Lost docker events, reconnecting in %s", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, eventsBackoffMax)
+		reconnecting = true
+	}
+}
+
+// route passes each event on to its instance until the subscription ends.
+func (er *eventRouter) route(ctx context.Context, msgs <-chan events.Message, errs <-chan error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-errs:
+			return err
+
+		case msg := <-msgs:
+			er.mu.Lock()
+			dsi := er.instances[msg.Actor.Attributes[instanceLabel]]
+			er.mu.Unlock()
+
+			if dsi != nil {
+				dsi.notify(msg)
+			}
+		}
+	}
+}
+
+// reconcile has every instance refresh its status.
+func (er *eventRouter) reconcile() {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	for _, dsi := range er.instances {
+		dsi.notify(events.Message{})
+	}
+}
+
+// MARK: notify
+
+// notify passes an event on to the instance's lifecycle without blocking. An
+// empty message asks for its status to be refreshed.
+func (dsi *dockerServerInstance) notify(msg events.Message) {
+	select {
+	case dsi.dockerEvents <- msg:
+	default:
+	}
+}
+
+// MARK: lifecycleEvent
+
+// lifecycleEvent brings the instance up to date with events from its
+// container.
+func (dsi *dockerServerInstance) lifecycleEvent(msgs ...events.Message) {
+	refresh := false
+	for _, msg := range msgs {
+		if dsi.lifecycleEventRefreshes(msg) {
+			refresh = true
+		}
+	}
+
+	// However many events there were, the status only needs refreshing once.
+	if refresh {
+		dsi.lifecycleActionUpdateStatus()
+	}
+}
+
+// lifecycleEventRefreshes acts on an event, reporting whether the status
+// needs refreshing afterwards.
+func (dsi *dockerServerInstance) lifecycleEventRefreshes(msg events.Message) bool {
+	switch {
+	case msg.Action == events.ActionOOM:
+		zerolog.Ctx(dsi.ctx).Warn().Msg("Container ran out of memory")
+		dsi.events.TerminalOut.Dispatch("Server ran out of memory")
+
+	case msg.Action == events.ActionDestroy:
+		dsi.lifecycleDestroyed(msg.Actor.ID)
+		return false
+
+	case msg.Action == events.ActionHealthStatusHealthy, msg.Action == events.ActionHealthStatusUnhealthy:
+		health := strings.TrimPrefix(string(msg.Action), string(events.ActionHealthStatus)+": ")
+		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Server is %s", health))
+
+	// Sent as each health check runs, which changes nothing.
+	case strings.HasPrefix(string(msg.Action), string(events.ActionHealthStatus)):
+		return false
+	}
+
+	return true
+}
+
+// lifecycleDestroyed errors the instance if its container was removed behind
+// our back. Containers we remove ourselves are replaced or forgotten first.
+func (dsi *dockerServerInstance) lifecycleDestroyed(containerID string) {
+	dsi.mu.Lock()
+	if containerID == "" || dsi.containerID != containerID {
+		dsi.mu.Unlock()
+		return
+	}
+
+	dsi.containerID = ""
+	if dsi.attachCancel != nil {
+		dsi.attachCancel()
+	}
+	dsi.mu.Unlock()
+
+	dsi.setErrored("Container was removed outside of serverpouch")
+}
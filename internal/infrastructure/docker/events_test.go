@@ -0,0 +1,188 @@
+package docker
+
+import (
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func receiveDockerEvent(t *testing.T, dsi *dockerServerInstance) events.Message {
+	select {
+	case msg := <-dsi.dockerEvents:
+		return msg
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "Docker event timed out")
+		return events.Message{}
+	}
+}
+
+// MARK: - eventRouter
+
+func TestEventRouter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Routes events to their instance", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		msgs, errs := make(chan events.Message), make(chan error)
+		mockClient.EXPECT().Events(
+			mock.Anything,
+			mock.Anything,
+		).Return(msgs, errs).Once()
+
+		dsi.eventRouter.add(dsi)
+		defer dsi.eventRouter.remove(dsi)
+
+		// Events for containers we don't know of are dropped.
+		msgs <- events.Message{
+			Action: events.ActionDie,
+			Actor:  events.Actor{ID: "other", Attributes: map[string]string{instanceLabel: uuid.Nil.String()}},
+		}
+
+		msgs <- events.Message{
+			Action: events.ActionDie,
+			Actor:  events.Actor{ID: "test", Attributes: map[string]string{instanceLabel: dsi.options.ID().String()}},
+		}
+
+		msg := receiveDockerEvent(t, dsi)
+		assert.Equal(t, events.ActionDie, msg.Action)
+		assert.Equal(t, "test", msg.Actor.ID)
+	})
+
+	t.Run("Ok - Reconciles after reconnecting", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		lostErrs := make(chan error, 1)
+		lostErrs <- errors.New("docker restarted")
+		mockClient.EXPECT().Events(
+			mock.Anything,
+			mock.Anything,
+		).Return(make(chan events.Message), lostErrs).Once()
+
+		mockClient.EXPECT().Events(
+			mock.Anything,
+			mock.Anything,
+		).Return(make(chan events.Message), make(chan error)).Once()
+
+		dsi.eventRouter.add(dsi)
+		defer dsi.eventRouter.remove(dsi)
+
+		// The empty message asks for the status to be refreshed.
+		assert.Equal(t, events.Message{}, receiveDockerEvent(t, dsi))
+		mockClient.AssertExpectations(t)
+	})
+}
+
+// MARK: - lifecycleEvent
+
+func TestLifecycleEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Refreshes the status", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusIdle
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+			},
+			nil,
+		).Once()
+
+		dsi.lifecycleEvent(events.Message{Action: events.ActionStart})
+		assert.Equal(t, server.ServerInstanceStatusRunning, dsi.Status())
+	})
+
+	t.Run("Ok - Waits for the container to be healthy", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusStarting
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{
+						Status: "running",
+						Health: &types.Health{Status: types.Starting},
+					},
+				},
+			},
+			nil,
+		).Once()
+
+		dsi.lifecycleEvent(events.Message{Action: events.ActionStart})
+		assert.Equal(t, server.ServerInstanceStatusStarting, dsi.Status())
+
+		// Health checks being run don't change anything.
+		dsi.lifecycleEvent(events.Message{Action: events.ActionHealthStatusRunning})
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{
+						Status: "running",
+						Health: &types.Health{Status: types.Healthy},
+					},
+				},
+			},
+			nil,
+		).Once()
+
+		dsi.lifecycleEvent(events.Message{Action: events.ActionHealthStatusHealthy})
+		assert.Equal(t, server.ServerInstanceStatusRunning, dsi.Status())
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Ok - Errors when the container is removed", func(t *testing.T) {
+		_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusRunning
+
+		// Another container's removal is none of our business.
+		dsi.lifecycleEvent(events.Message{Action: events.ActionDestroy, Actor: events.Actor{ID: "other"}})
+		assert.Equal(t, server.ServerInstanceStatusRunning, dsi.Status())
+
+		dsi.lifecycleEvent(events.Message{Action: events.ActionDestroy, Actor: events.Actor{ID: uuid.Nil.String()}})
+		assert.Equal(t, server.ServerInstanceStatusErrored, dsi.Status())
+		assert.Equal(t, "Container was removed outside of serverpouch", dsi.StatusReason())
+		assert.Empty(t, dsi.containerID)
+	})
+}
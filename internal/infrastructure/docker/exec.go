@@ -0,0 +1,93 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/pkg/errors"
+)
+
+// execPollInterval is how often an exec is inspected while waiting for it to
+// exit.
+const execPollInterval = 100 * time.Millisecond
+
+// MARK: Exec
+
+func (dsi *dockerServerInstance) Exec(ctx context.Context, opts server.ExecOptions) (server.ExecProcess, error) {
+	dsi.mu.RLock()
+	containerID := dsi.containerID
+	status := dsi.status
+	dsi.mu.RUnlock()
+
+	if status != server.ServerInstanceStatusStarting && status != server.ServerInstanceStatusRunning {
+		return nil, &server.InvalidStatusError{Action: "Exec", Status: status}
+	}
+
+	exec, err := dsi.client.ContainerExecCreate(ctx, containerID, container.ExecOptions{
+		User:         opts.User,
+		AttachStdout: true,
+		AttachStderr: true,
+		Env:          opts.Env,
+		WorkingDir:   opts.WorkingDir,
+		Cmd:          opts.Cmd,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to create exec")
+	}
+
+	// Attaching starts the command. Without a TTY its output arrives
+	// multiplexed, just like the logs.
+	attach, err := dsi.client.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to attach to exec")
+	}
+
+	return &dockerExecProcess{
+		dockerLogReader: dockerLogReader{
+			reader: struct {
+				io.Reader
+				io.Closer
+			}{attach.Reader, attach.Conn},
+		},
+		client: dsi.client,
+		execID: exec.ID,
+	}, nil
+}
+
+// MARK: dockerExecProcess
+
+// dockerExecProcess reads an exec's output. Closing it only detaches, the
+// command itself keeps running until it exits.
+type dockerExecProcess struct {
+	dockerLogReader
+
+	client client.APIClient
+	execID string
+}
+
+var _ server.ExecProcess = (*dockerExecProcess)(nil)
+
+func (ep *dockerExecProcess) Wait(ctx context.Context) (int, error) {
+	// The output can end slightly before docker notices the command exited.
+	for {
+		inspect, err := ep.client.ContainerExecInspect(ctx, ep.execID)
+		if err != nil {
+			return 0, errors.Wrap(err, "Unable to inspect exec")
+		}
+
+		if !inspect.Running {
+			return inspect.ExitCode, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(execPollInterval):
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package docker
+
+import (
+	"io"
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MARK: - Exec
+
+func TestExec(t *testing.T) {
+	t.Run("Ok - Reads output and waits for exit", func(t *testing.T) {
+		mockAPIClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{InstanceID: uuid.Nil})
+		dsi.containerID = "test-container"
+		dsi.status = server.ServerInstanceStatusRunning
+
+		attach, containerConn := testHijackedResponse(t)
+
+		mockAPIClient.EXPECT().ContainerExecCreate(mock.Anything, "test-container", container.ExecOptions{
+			User:         "1000",
+			AttachStdout: true,
+			AttachStderr: true,
+			Env:          []string{"PGUSER=app"},
+			WorkingDir:   "/data",
+			Cmd:          []string{"pg_dump", "app"},
+		}).Return(types.IDResponse{ID: "test-exec"}, nil)
+		mockAPIClient.EXPECT().ContainerExecAttach(mock.Anything, "test-exec", container.ExecAttachOptions{}).Return(attach, nil)
+
+		// The output ends before docker notices the command exited.
+		mockAPIClient.EXPECT().ContainerExecInspect(mock.Anything, "test-exec").Return(container.ExecInspect{Running: true}, nil).Once()
+		mockAPIClient.EXPECT().ContainerExecInspect(mock.Anything, "test-exec").Return(container.ExecInspect{ExitCode: 3}, nil).Once()
+
+		process, err := dsi.Exec(t.Context(), server.ExecOptions{
+			Cmd:        []string{"pg_dump", "app"},
+			Env:        []string{"PGUSER=app"},
+			WorkingDir: "/data",
+			User:       "1000",
+		})
+		assert.NoError(t, err)
+		defer process.Close()
+
+		go func() {
+			stdcopy.NewStdWriter(containerConn, stdcopy.Stdout).Write([]byte("2025-01-02T03:04:05Z dumped\n"))
+			stdcopy.NewStdWriter(containerConn, stdcopy.Stderr).Write([]byte("warning"))
+			containerConn.Close()
+		}()
+
+		lines := []server.LogLine{}
+		for {
+			line, err := process.Next()
+			if err == io.EOF {
+				break
+			}
+
+			assert.NoError(t, err)
+			lines = append(lines, line)
+		}
+
+		// Timestamps printed by the command are left alone.
+		assert.Equal(t, []server.LogLine{
+			{Stream: server.LogStreamStdout, Text: "2025-01-02T03:04:05Z dumped"},
+			{Stream: server.LogStreamStderr, Text: "warning"},
+		}, lines)
+
+		exitCode, err := process.Wait(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, exitCode)
+	})
+
+	t.Run("Err - Not running", func(t *testing.T) {
+		_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{InstanceID: uuid.Nil})
+		dsi.containerID = "test-container"
+		dsi.status = server.ServerInstanceStatusIdle
+
+		var statusErr *server.InvalidStatusError
+		_, err := dsi.Exec(t.Context(), server.ExecOptions{Cmd: []string{"true"}})
+		assert.ErrorAs(t, err, &statusErr)
+	})
+}
@@ -0,0 +1,469 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/client"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// Files are accessed directly on the host, named volumes through the
+// directory docker keeps them in. Every access goes through an os.Root opened
+// at the volume, so neither .. nor symlinks can reach outside of it.
+
+func (dsi *dockerServerInstance) Files() server.Files {
+	return &dockerFiles{dsi: dsi}
+}
+
+type dockerFiles struct {
+	dsi *dockerServerInstance
+}
+
+var _ server.Files = (*dockerFiles)(nil)
+
+// volumePath is a path resolved to the volume it's within.
+type volumePath struct {
+	// source is the volume's host path or name, and target where it's mounted.
+	source string
+	target string
+	// rel is the path relative to the volume, using the host's separator.
+	rel string
+}
+
+// containerPath is the path as seen from within the container.
+func (vp volumePath) containerPath() string {
+	return path.Join(vp.target, filepath.ToSlash(vp.rel))
+}
+
+// resolveVolumePath finds the volume the container path is within. Paths must
+// be absolute, and may not contain .. at all rather than having it cleaned
+// away, so they can never refer to anything outside of their volume.
+func resolveVolumePath(volumes map[string]string, containerPath string) (volumePath, error) {
+	if !path.IsAbs(containerPath) {
+		return volumePath{}, &server.InvalidPathError{Path: containerPath, Reason: "must be absolute"}
+	}
+
+	if slices.Contains(strings.Split(containerPath, "/"), "..") {
+		return volumePath{}, &server.InvalidPathError{Path: containerPath, Reason: "may not contain .."}
+	}
+
+	if strings.ContainsRune(containerPath, 0) {
+		return volumePath{}, &server.InvalidPathError{Path: containerPath, Reason: "may not contain NUL"}
+	}
+
+	cleanPath := path.Clean(containerPath)
+
+	// Volumes may be mounted within one another, so the deepest one wins.
+	var found volumePath
+	for source, target := range volumes {
+		target = path.Clean(target)
+
+		rel, ok := strings.CutPrefix(cleanPath, target)
+		if !ok || (rel != "" && !strings.HasPrefix(rel, "/") && target != "/") {
+			continue
+		}
+
+		if found.target == "" || len(target) > len(found.target) {
+			rel = strings.TrimPrefix(rel, "/")
+			if rel == "" {
+				rel = "."
+			}
+
+			found = volumePath{source: source, target: target, rel: filepath.FromSlash(rel)}
+		}
+	}
+
+	if found.target == "" {
+		return volumePath{}, &server.InvalidPathError{Path: containerPath, Reason: "not within any of the server's volumes"}
+	}
+
+	return found, nil
+}
+
+// resolve resolves the path against the instance's current volumes, opening
+// the volume it's within.
+func (df *dockerFiles) resolve(ctx context.Context, containerPath string) (*os.Root, volumePath, error) {
+	df.dsi.mu.RLock()
+	volumes := df.dsi.options.ContainerVolumes
+	df.dsi.mu.RUnlock()
+
+	vp, err := resolveVolumePath(volumes, containerPath)
+	if err != nil {
+		return nil, volumePath{}, err
+	}
+
+	root, err := df.openVolume(ctx, vp.source)
+	if err != nil {
+		return nil, volumePath{}, err
+	}
+
+	return root, vp, nil
+}
+
+func (df *dockerFiles) openVolume(ctx context.Context, source string) (*os.Root, error) {
+	hostPath := source
+
+	// Anything that isn't a path is a named volume managed by docker.
+	if !filepath.IsAbs(source) {
+		volume, err := df.dsi.client.VolumeInspect(ctx, source)
+		switch {
+		case client.IsErrNotFound(err):
+			return nil, errors.Wrapf(server.ErrFileNotFound, "volume \"%s\" doesn't exist yet", source)
+		case err != nil:
+			return nil, errors.Wrapf(err, "Unable to inspect volume \"%s\"", source)
+		}
+
+		hostPath = volume.Mountpoint
+	}
+
+	root, err := os.OpenRoot(hostPath)
+	if err != nil {
+		return nil, fileError(source, err)
+	}
+
+	return root, nil
+}
+
+// MARK: List
+
+func (df *dockerFiles) List(ctx context.Context, containerPath string) ([]server.FileInfo, error) {
+	if containerPath == "" {
+		return df.listVolumes(ctx)
+	}
+
+	root, vp, err := df.resolve(ctx, containerPath)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+
+	dir, err := root.Open(vp.rel)
+	if err != nil {
+		return nil, fileError(containerPath, err)
+	}
+	defer dir.Close()
+
+	entries, err := dir.ReadDir(-1)
+	if err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) && errors.Is(pathErr.Err, syscall.ENOTDIR) {
+			return nil, &server.InvalidPathError{Path: containerPath, Reason: "not a directory"}
+		}
+
+		return nil, fileError(containerPath, err)
+	}
+
+	files := make([]server.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			// The file was removed while listing.
+			continue
+		}
+
+		files = append(files, toFileInfo(path.Join(vp.containerPath(), entry.Name()), info))
+	}
+
+	return files, nil
+}
+
+// listVolumes lists where each volume is mounted, skipping those not created yet.
+func (df *dockerFiles) listVolumes(ctx context.Context) ([]server.FileInfo, error) {
+	df.dsi.mu.RLock()
+	volumes := df.dsi.options.ContainerVolumes
+	df.dsi.mu.RUnlock()
+
+	files := []server.FileInfo{}
+	for source, target := range volumes {
+		root, err := df.openVolume(ctx, source)
+		if errors.Is(err, server.ErrFileNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		info, err := root.Stat(".")
+		root.Close()
+		if err != nil {
+			return nil, fileError(target, err)
+		}
+
+		files = append(files, toFileInfo(path.Clean(target), info))
+	}
+
+	slices.SortFunc(files, func(a, b server.FileInfo) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return files, nil
+}
+
+// MARK: Open
+
+func (df *dockerFiles) Open(ctx context.Context, containerPath string) (io.ReadCloser, server.FileInfo, error) {
+	root, vp, err := df.resolve(ctx, containerPath)
+	if err != nil {
+		return nil, server.FileInfo{}, err
+	}
+	defer root.Close()
+
+	file, err := root.Open(vp.rel)
+	if err != nil {
+		return nil, server.FileInfo{}, fileError(containerPath, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, server.FileInfo{}, fileError(containerPath, err)
+	}
+
+	if !info.Mode().IsRegular() {
+		file.Close()
+		return nil, server.FileInfo{}, &server.InvalidPathError{Path: containerPath, Reason: "not a regular file"}
+	}
+
+	return file, toFileInfo(vp.containerPath(), info), nil
+}
+
+// MARK: Write
+
+func (df *dockerFiles) Write(ctx context.Context, containerPath string, contents io.Reader) (server.FileInfo, error) {
+	root, vp, err := df.resolve(ctx, containerPath)
+	if err != nil {
+		return server.FileInfo{}, err
+	}
+	defer root.Close()
+
+	if vp.rel == "." {
+		return server.FileInfo{}, &server.InvalidPathError{Path: containerPath, Reason: "is a volume"}
+	}
+
+	file, err := root.OpenFile(vp.rel, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return server.FileInfo{}, fileError(containerPath, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, contents); err != nil {
+		return server.FileInfo{}, errors.Wrapf(err, "Unable to write \"%s\"", containerPath)
+	}
+
+	return df.created(root, vp, file)
+}
+
+// MARK: Mkdir
+
+func (df *dockerFiles) Mkdir(ctx context.Context, containerPath string) (server.FileInfo, error) {
+	root, vp, err := df.resolve(ctx, containerPath)
+	if err != nil {
+		return server.FileInfo{}, err
+	}
+	defer root.Close()
+
+	if err := root.Mkdir(vp.rel, 0o755); err != nil {
+		return server.FileInfo{}, fileError(containerPath, err)
+	}
+
+	dir, err := root.Open(vp.rel)
+	if err != nil {
+		return server.FileInfo{}, fileError(containerPath, err)
+	}
+	defer dir.Close()
+
+	return df.created(root, vp, dir)
+}
+
+// created hands a newly created file to whoever owns its directory, as the
+// server likely runs as someone other than us and would otherwise be unable to
+// modify it.
+func (df *dockerFiles) created(root *os.Root, vp volumePath, file *os.File) (server.FileInfo, error) {
+	parent, err := root.Stat(filepath.Dir(vp.rel))
+	if err != nil {
+		return server.FileInfo{}, fileError(vp.containerPath(), err)
+	}
+
+	if stat, ok := parent.Sys().(*syscall.Stat_t); ok {
+		if err := file.Chown(int(stat.Uid), int(stat.Gid)); err != nil && !errors.Is(err, fs.ErrPermission) {
+			return server.FileInfo{}, errors.Wrapf(err, "Unable to change the owner of \"%s\"", vp.containerPath())
+		}
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return server.FileInfo{}, fileError(vp.containerPath(), err)
+	}
+
+	return toFileInfo(vp.containerPath(), info), nil
+}
+
+// MARK: Rename
+
+func (df *dockerFiles) Rename(ctx context.Context, from string, to string) (server.FileInfo, error) {
+	root, fromPath, err := df.resolve(ctx, from)
+	if err != nil {
+		return server.FileInfo{}, err
+	}
+	defer root.Close()
+
+	df.dsi.mu.RLock()
+	volumes := df.dsi.options.ContainerVolumes
+	df.dsi.mu.RUnlock()
+
+	toPath, err := resolveVolumePath(volumes, to)
+	if err != nil {
+		return server.FileInfo{}, err
+	}
+
+	if toPath.source != fromPath.source {
+		return server.FileInfo{}, &server.InvalidPathError{Path: to, Reason: "must be within the same volume"}
+	}
+
+	if fromPath.rel == "." || toPath.rel == "." {
+		return server.FileInfo{}, &server.InvalidPathError{Path: from, Reason: "volumes can't be renamed"}
+	}
+
+	if _, err := root.Lstat(toPath.rel); err == nil {
+		return server.FileInfo{}, errors.Wrapf(server.ErrFileExists, "\"%s\" already exists", to)
+	}
+
+	// Both parents are opened through the root, and then only their direct
+	// children are renamed, so the rename can't escape it either.
+	fromDir, err := root.Open(filepath.Dir(fromPath.rel))
+	if err != nil {
+		return server.FileInfo{}, fileError(from, err)
+	}
+	defer fromDir.Close()
+
+	toDir, err := root.Open(filepath.Dir(toPath.rel))
+	if err != nil {
+		return server.FileInfo{}, fileError(to, err)
+	}
+	defer toDir.Close()
+
+	err = unix.Renameat(int(fromDir.Fd()), filepath.Base(fromPath.rel), int(toDir.Fd()), filepath.Base(toPath.rel))
+	if err != nil {
+		return server.FileInfo{}, fileError(from, &fs.PathError{Op: "rename", Path: from, Err: err})
+	}
+
+	info, err := root.Lstat(toPath.rel)
+	if err != nil {
+		return server.FileInfo{}, fileError(to, err)
+	}
+
+	return toFileInfo(toPath.containerPath(), info), nil
+}
+
+// MARK: Remove
+
+func (df *dockerFiles) Remove(ctx context.Context, containerPath string, recursive bool) error {
+	root, vp, err := df.resolve(ctx, containerPath)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	if vp.rel == "." {
+		return &server.InvalidPathError{Path: containerPath, Reason: "volumes can't be removed"}
+	}
+
+	if recursive {
+		return removeAll(root, vp.rel, containerPath)
+	}
+
+	if err := root.Remove(vp.rel); err != nil {
+		return fileError(containerPath, err)
+	}
+
+	return nil
+}
+
+// removeAll removes the file, and if it's a directory everything in it. Links
+// are removed rather than followed.
+func removeAll(root *os.Root, rel string, containerPath string) error {
+	info, err := root.Lstat(rel)
+	if err != nil {
+		return fileError(containerPath, err)
+	}
+
+	if info.IsDir() {
+		dir, err := root.Open(rel)
+		if err != nil {
+			return fileError(containerPath, err)
+		}
+
+		entries, err := dir.ReadDir(-1)
+		dir.Close()
+		if err != nil {
+			return fileError(containerPath, err)
+		}
+
+		for _, entry := range entries {
+			err := removeAll(root, filepath.Join(rel, entry.Name()), path.Join(containerPath, entry.Name()))
+			if err != nil && !errors.Is(err, server.ErrFileNotFound) {
+				return err
+			}
+		}
+	}
+
+	if err := root.Remove(rel); err != nil {
+		return fileError(containerPath, err)
+	}
+
+	return nil
+}
+
+// MARK: helpers
+
+func toFileInfo(containerPath string, info fs.FileInfo) server.FileInfo {
+	fileType := server.FileTypeFile
+	switch {
+	case info.Mode()&fs.ModeSymlink != 0:
+		fileType = server.FileTypeSymlink
+	case info.IsDir():
+		fileType = server.FileTypeDirectory
+	}
+
+	return server.FileInfo{
+		Path:    containerPath,
+		Type:    fileType,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}
+}
+
+// fileError maps the filesystem's errors onto the domain's.
+func fileError(containerPath string, err error) error {
+	var pathErr *fs.PathError
+	errors.As(err, &pathErr)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return errors.Wrapf(server.ErrFileNotFound, "\"%s\" doesn't exist", containerPath)
+	// ENOTEMPTY also counts as fs.ErrExist, so it's checked first.
+	case errors.Is(err, syscall.ENOTEMPTY):
+		return errors.Wrapf(server.ErrDirectoryNotEmpty, "\"%s\" isn't empty", containerPath)
+	case errors.Is(err, fs.ErrExist):
+		return errors.Wrapf(server.ErrFileExists, "\"%s\" already exists", containerPath)
+	case errors.Is(err, syscall.ENOTDIR):
+		return &server.InvalidPathError{Path: containerPath, Reason: "a parent is not a directory"}
+	case errors.Is(err, syscall.EISDIR):
+		return &server.InvalidPathError{Path: containerPath, Reason: "is a directory"}
+	// The root doesn't export its error for paths leading out of it.
+	case pathErr != nil && pathErr.Err.Error() == "path escapes from parent":
+		return &server.InvalidPathError{Path: containerPath, Reason: "leads outside of its volume"}
+	default:
+		return errors.Wrapf(err, "Unable to access \"%s\"", containerPath)
+	}
+}
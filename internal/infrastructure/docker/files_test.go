@@ -0,0 +1,182 @@
+package docker
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/errdefs"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MARK: - resolveVolumePath
+
+func TestResolveVolumePath(t *testing.T) {
+	volumes := map[string]string{
+		"/srv/data":   "/data",
+		"/srv/worlds": "/data/worlds/",
+		"configs":     "/config",
+	}
+
+	t.Run("Ok - Deepest volume wins", func(t *testing.T) {
+		vp, err := resolveVolumePath(volumes, "/data/worlds/overworld/level.dat")
+		assert.NoError(t, err)
+		assert.Equal(t, "/srv/worlds", vp.source)
+		assert.Equal(t, filepath.FromSlash("overworld/level.dat"), vp.rel)
+		assert.Equal(t, "/data/worlds/overworld/level.dat", vp.containerPath())
+	})
+
+	t.Run("Ok - Volume itself", func(t *testing.T) {
+		vp, err := resolveVolumePath(volumes, "/config/")
+		assert.NoError(t, err)
+		assert.Equal(t, "configs", vp.source)
+		assert.Equal(t, ".", vp.rel)
+	})
+
+	t.Run("Err - Outside of volumes", func(t *testing.T) {
+		var pathErr *server.InvalidPathError
+		for _, containerPath := range []string{
+			"/database",
+			"/etc/passwd",
+			"/data/../etc/passwd",
+			"/data/worlds/..",
+			"data/server.properties",
+			"/data/\x00",
+		} {
+			_, err := resolveVolumePath(volumes, containerPath)
+			assert.ErrorAs(t, err, &pathErr, containerPath)
+		}
+	})
+}
+
+// MARK: - Files
+
+func testDockerFiles(t *testing.T) (string, server.Files) {
+	hostPath := t.TempDir()
+	_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+		InstanceID:       uuid.Nil,
+		ContainerVolumes: map[string]string{hostPath: "/data"},
+	})
+
+	return hostPath, dsi.Files()
+}
+
+func TestFiles(t *testing.T) {
+	t.Run("Ok - Round trip", func(t *testing.T) {
+		hostPath, files := testDockerFiles(t)
+		ctx := t.Context()
+
+		dir, err := files.Mkdir(ctx, "/data/plugins")
+		assert.NoError(t, err)
+		assert.Equal(t, server.FileTypeDirectory, dir.Type)
+
+		file, err := files.Write(ctx, "/data/plugins/config.yml", strings.NewReader("motd: hi"))
+		assert.NoError(t, err)
+		assert.Equal(t, server.FileInfo{Path: "/data/plugins/config.yml", Type: server.FileTypeFile, Size: 8, ModTime: file.ModTime}, file)
+
+		renamed, err := files.Rename(ctx, "/data/plugins/config.yml", "/data/config.yml")
+		assert.NoError(t, err)
+		assert.Equal(t, "/data/config.yml", renamed.Path)
+
+		listing, err := files.List(ctx, "/data")
+		assert.NoError(t, err)
+		paths := []string{}
+		for _, info := range listing {
+			paths = append(paths, info.Path)
+		}
+		assert.ElementsMatch(t, []string{"/data/plugins", "/data/config.yml"}, paths)
+
+		contents, info, err := files.Open(ctx, "/data/config.yml")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(8), info.Size)
+		body, _ := io.ReadAll(contents)
+		contents.Close()
+		assert.Equal(t, "motd: hi", string(body))
+
+		onHost, err := os.ReadFile(filepath.Join(hostPath, "config.yml"))
+		assert.NoError(t, err)
+		assert.Equal(t, "motd: hi", string(onHost))
+
+		assert.NoError(t, files.Remove(ctx, "/data/config.yml", false))
+		_, _, err = files.Open(ctx, "/data/config.yml")
+		assert.ErrorIs(t, err, server.ErrFileNotFound)
+	})
+
+	t.Run("Ok - Lists volumes", func(t *testing.T) {
+		mockAPIClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.Nil,
+			ContainerVolumes: map[string]string{
+				t.TempDir(): "/data",
+				"configs":   "/config",
+				"missing":   "/missing",
+			},
+		})
+
+		mockAPIClient.EXPECT().VolumeInspect(mock.Anything, "configs").Return(volume.Volume{Mountpoint: t.TempDir()}, nil)
+		mockAPIClient.EXPECT().VolumeInspect(mock.Anything, "missing").Return(volume.Volume{}, errdefs.NotFound(errors.New("no such volume")))
+
+		listing, err := dsi.Files().List(t.Context(), "")
+		assert.NoError(t, err)
+		assert.Len(t, listing, 2)
+		assert.Equal(t, "/config", listing[0].Path)
+		assert.Equal(t, "/data", listing[1].Path)
+	})
+
+	t.Run("Ok - Removes recursively", func(t *testing.T) {
+		hostPath, files := testDockerFiles(t)
+		ctx := t.Context()
+
+		assert.NoError(t, os.MkdirAll(filepath.Join(hostPath, "world", "region"), 0o755))
+		assert.NoError(t, os.WriteFile(filepath.Join(hostPath, "world", "region", "r.0.0.mca"), nil, 0o644))
+
+		err := files.Remove(ctx, "/data/world", false)
+		assert.ErrorIs(t, err, server.ErrDirectoryNotEmpty)
+
+		assert.NoError(t, files.Remove(ctx, "/data/world", true))
+		assert.NoDirExists(t, filepath.Join(hostPath, "world"))
+	})
+
+	t.Run("Err - Symlinks can't escape the volume", func(t *testing.T) {
+		hostPath, files := testDockerFiles(t)
+		ctx := t.Context()
+
+		outside := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0o600))
+		assert.NoError(t, os.Symlink(outside, filepath.Join(hostPath, "escape")))
+
+		var pathErr *server.InvalidPathError
+		_, _, err := files.Open(ctx, "/data/escape/secret")
+		assert.ErrorAs(t, err, &pathErr)
+
+		_, err = files.Write(ctx, "/data/escape/planted", strings.NewReader("planted"))
+		assert.ErrorAs(t, err, &pathErr)
+		assert.NoFileExists(t, filepath.Join(outside, "planted"))
+
+		_, err = files.Rename(ctx, "/data/escape/secret", "/data/secret")
+		assert.ErrorAs(t, err, &pathErr)
+		assert.FileExists(t, filepath.Join(outside, "secret"))
+	})
+
+	t.Run("Err - Already exists", func(t *testing.T) {
+		_, files := testDockerFiles(t)
+		ctx := t.Context()
+
+		_, err := files.Mkdir(ctx, "/data/world")
+		assert.NoError(t, err)
+		_, err = files.Mkdir(ctx, "/data/world")
+		assert.ErrorIs(t, err, server.ErrFileExists)
+
+		_, err = files.Write(ctx, "/data/level.dat", strings.NewReader(""))
+		assert.NoError(t, err)
+		_, err = files.Rename(ctx, "/data/level.dat", "/data/world")
+		assert.ErrorIs(t, err, server.ErrFileExists)
+	})
+}
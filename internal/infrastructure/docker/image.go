@@ -0,0 +1,142 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// MARK: CheckImage
+
+func (dsi *dockerServerInstance) CheckImage(ctx context.Context) (*server.ImageUpdate, error) {
+	dsi.mu.RLock()
+	containerID := dsi.containerID
+	imageName := dsi.options.Image
+	dsi.mu.RUnlock()
+
+	if containerID == "" {
+		return nil, &server.InvalidStatusError{Action: "Check image", Status: dsi.Status()}
+	}
+
+	inspect, err := dsi.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to inspect container")
+	}
+
+	current, _, err := dsi.client.ImageInspectWithRaw(ctx, inspect.Image)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to inspect image")
+	}
+
+	// Asks the registry what the tag resolves to, without pulling anything.
+	distribution, err := dsi.client.DistributionInspect(ctx, imageName, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to look up image \"%s\"", imageName)
+	}
+
+	latestDigest := distribution.Descriptor.Digest.String()
+	return &server.ImageUpdate{
+		Image:         imageName,
+		CurrentDigest: imageDigest(current.RepoDigests, latestDigest),
+		LatestDigest:  latestDigest,
+		Available:     !slices.Contains(imageDigests(current.RepoDigests), latestDigest),
+	}, nil
+}
+
+// MARK: UpdateImage
+
+func (dsi *dockerServerInstance) UpdateImage() (update *server.ImageUpdate, err error) {
+	defer observeAction("update-image", time.Now(), &err)
+
+	actionDone, err := dsi.lifecycleAction(dsi.ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to acquire update image action")
+	}
+	defer actionDone()
+
+	existing, err := dsi.lifecycleFindContainer(dsi.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		err := &server.InvalidStatusError{Action: "Update image", Status: dsi.Status()}
+		dsi.events.TerminalOut.Dispatch(err.Error())
+		return nil, err
+	}
+
+	if err := dsi.lifecyclePullImage(dsi.ctx); err != nil {
+		return nil, err
+	}
+
+	latest, _, err := dsi.client.ImageInspectWithRaw(dsi.ctx, dsi.options.Image)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to inspect image")
+	}
+
+	current, _, err := dsi.client.ImageInspectWithRaw(dsi.ctx, existing.ImageID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to inspect image")
+	}
+
+	latestDigest := imageDigest(latest.RepoDigests, "")
+	update = &server.ImageUpdate{
+		Image:         dsi.options.Image,
+		CurrentDigest: imageDigest(current.RepoDigests, latestDigest),
+		LatestDigest:  latestDigest,
+		Available:     latest.ID != current.ID,
+	}
+
+	if !update.Available {
+		zerolog.Ctx(dsi.ctx).Info().Msgf("Image \"%s\" is up to date", dsi.options.Image)
+		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Image \"%s\" is up to date", dsi.options.Image))
+		return update, nil
+	}
+
+	zerolog.Ctx(dsi.ctx).Info().Msgf("Updating image \"%s\" to %s", dsi.options.Image, latest.ID)
+	dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Updating image \"%s\" to %s", dsi.options.Image, latest.ID))
+
+	err = dsi.lifecycleRecreate(existing, dsi.options, "update-image")
+	if err != nil {
+		return nil, err
+	}
+
+	update.Applied = true
+	return update, nil
+}
+
+// MARK: Helpers
+
+// imageDigests strips the repository from each of an image's repo digests.
+func imageDigests(repoDigests []string) []string {
+	digests := make([]string, 0, len(repoDigests))
+	for _, repoDigest := range repoDigests {
+		if _, digest, ok := strings.Cut(repoDigest, "@"); ok {
+			digests = append(digests, digest)
+		}
+	}
+
+	return digests
+}
+
+// imageDigest picks the digest to report for an image, preferring the one
+// given should the image have it. Images pulled by several names have a
+// digest for each, and those built locally have none.
+func imageDigest(repoDigests []string, preferred string) string {
+	digests := imageDigests(repoDigests)
+	switch {
+	case len(digests) == 0:
+		return ""
+	case slices.Contains(digests, preferred):
+		return preferred
+	default:
+		return digests[0]
+	}
+}
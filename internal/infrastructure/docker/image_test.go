@@ -0,0 +1,307 @@
+package docker
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/google/uuid"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MARK: - CheckImage
+
+func TestCheckImage(t *testing.T) {
+	t.Parallel()
+
+	checkImage := func(t *testing.T, latest v1.Descriptor) *server.ImageUpdate {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "itzg/minecraft-server",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+
+		mockClient.EXPECT().ContainerInspect(
+			t.Context(),
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{Image: "sha256:image"},
+			},
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ImageInspectWithRaw(
+			t.Context(),
+			"sha256:image",
+		).Return(
+			types.ImageInspect{
+				ID:          "sha256:image",
+				RepoDigests: []string{"itzg/minecraft-server@sha256:current"},
+			},
+			nil,
+			nil,
+		).Once()
+
+		mockClient.EXPECT().DistributionInspect(
+			t.Context(),
+			"itzg/minecraft-server",
+			"",
+		).Return(
+			registry.DistributionInspect{Descriptor: latest},
+			nil,
+		).Once()
+
+		update, err := dsi.CheckImage(t.Context())
+		assert.NoError(t, err)
+		return update
+	}
+
+	t.Run("Ok - Update available", func(t *testing.T) {
+		update := checkImage(t, v1.Descriptor{Digest: "sha256:latest"})
+		assert.Equal(t, &server.ImageUpdate{
+			Image:         "itzg/minecraft-server",
+			CurrentDigest: "sha256:current",
+			LatestDigest:  "sha256:latest",
+			Available:     true,
+		}, update)
+	})
+
+	t.Run("Ok - Up to date", func(t *testing.T) {
+		update := checkImage(t, v1.Descriptor{Digest: "sha256:current"})
+		assert.False(t, update.Available)
+		assert.Equal(t, "sha256:current", update.LatestDigest)
+	})
+
+	t.Run("Error - Without a container", func(t *testing.T) {
+		_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "itzg/minecraft-server",
+		})
+
+		_, err := dsi.CheckImage(t.Context())
+		assert.ErrorAs(t, err, new(*server.InvalidStatusError))
+	})
+}
+
+// MARK: - UpdateImage
+
+func TestUpdateImage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Recreates the server from the new image", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID:       uuid.New(),
+			Image:            "itzg/minecraft-server",
+			ContainerVolumes: map[string]string{"minecraft": "/data"},
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusRunning
+
+		// First, it finds the running container
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:      dsi.containerID,
+				Image:   dsi.options.Image,
+				ImageID: "sha256:old",
+				State:   "running",
+				Names:   []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		// Second, it pulls the image and compares it with the container's
+		mockClient.EXPECT().ImagePull(
+			dsi.ctx,
+			dsi.options.Image,
+			image.PullOptions{},
+		).Return(
+			io.NopCloser(strings.NewReader(`{"status":"Downloaded newer image"}`)),
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ImageInspectWithRaw(
+			dsi.ctx,
+			dsi.options.Image,
+		).Return(
+			types.ImageInspect{ID: "sha256:new", RepoDigests: []string{"itzg/minecraft-server@sha256:latest"}},
+			nil,
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ImageInspectWithRaw(
+			dsi.ctx,
+			"sha256:old",
+		).Return(
+			types.ImageInspect{ID: "sha256:old", RepoDigests: []string{"itzg/minecraft-server@sha256:current"}},
+			nil,
+			nil,
+		).Once()
+
+		// Third, it replaces the container, keeping its volumes
+		mockClient.EXPECT().ContainerStop(
+			dsi.ctx,
+			dsi.containerID,
+			container.StopOptions{},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().ContainerRemove(
+			dsi.ctx,
+			dsi.containerID,
+			container.RemoveOptions{},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().ImageList(
+			dsi.ctx,
+			image.ListOptions{All: true},
+		).Return(
+			[]image.Summary{{
+				Labels: map[string]string{
+					"org.opencontainers.image.ref.name": dsi.options.Image,
+				},
+			}},
+			nil,
+		).Once()
+
+		opts, hostOpts := dsi.options.toOptions()
+		mockClient.EXPECT().ContainerCreate(
+			dsi.ctx,
+			opts,
+			hostOpts,
+			(*network.NetworkingConfig)(nil),
+			(*v1.Platform)(nil),
+			dsi.options.InstanceID.String(),
+		).Return(
+			container.CreateResponse{ID: "recreated"},
+			nil,
+		).Once()
+
+		// Finally, it starts the new container since the old one was running
+		attach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			"recreated",
+			mock.Anything,
+		).Return(attach, nil).Once()
+
+		mockClient.EXPECT().ContainerStart(
+			dsi.ctx,
+			"recreated",
+			container.StartOptions{},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			"recreated",
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+			},
+			nil,
+		).Once()
+
+		go dsi.lifecycle()
+		update, err := dsi.UpdateImage()
+		assert.NoError(t, err)
+		assert.Equal(t, &server.ImageUpdate{
+			Image:         "itzg/minecraft-server",
+			CurrentDigest: "sha256:current",
+			LatestDigest:  "sha256:latest",
+			Available:     true,
+			Applied:       true,
+		}, update)
+		assert.Equal(t, "recreated", dsi.containerID)
+		assert.Equal(t, server.ServerInstanceStatusRunning, dsi.Status())
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Ok - Leaves an up to date server alone", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "itzg/minecraft-server",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusIdle
+
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:      dsi.containerID,
+				Image:   dsi.options.Image,
+				ImageID: "sha256:image",
+				State:   "created",
+				Names:   []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ImagePull(
+			dsi.ctx,
+			dsi.options.Image,
+			image.PullOptions{},
+		).Return(
+			io.NopCloser(strings.NewReader(`{"status":"Image is up to date"}`)),
+			nil,
+		).Once()
+
+		mockClient.EXPECT().ImageInspectWithRaw(
+			dsi.ctx,
+			mock.Anything,
+		).Return(
+			types.ImageInspect{ID: "sha256:image", RepoDigests: []string{"itzg/minecraft-server@sha256:current"}},
+			nil,
+			nil,
+		).Twice()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "created"},
+				},
+			},
+			nil,
+		).Once()
+
+		go dsi.lifecycle()
+		update, err := dsi.UpdateImage()
+		assert.NoError(t, err)
+		assert.False(t, update.Available)
+		assert.False(t, update.Applied)
+		assert.Equal(t, uuid.Nil.String(), dsi.containerID)
+		mockClient.AssertExpectations(t)
+	})
+}
+
+// MARK: - imageDigest
+
+func TestImageDigest(t *testing.T) {
+	t.Parallel()
+
+	repoDigests := []string{"mirror.example.com/minecraft@sha256:mirror", "itzg/minecraft-server@sha256:hub"}
+
+	assert.Equal(t, "", imageDigest(nil, "sha256:hub"))
+	assert.Equal(t, "sha256:hub", imageDigest(repoDigests, "sha256:hub"))
+	assert.Equal(t, "sha256:mirror", imageDigest(repoDigests, "sha256:other"))
+}
@@ -2,10 +2,14 @@ package docker
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
+	"oppossome/serverpouch/internal/common/metrics"
 	"oppossome/serverpouch/internal/domain/server"
 
+	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/client"
 	"github.com/rs/zerolog"
 )
@@ -20,13 +24,31 @@ type dockerServerInstance struct {
 	options *DockerServerInstanceOptions
 
 	actionChan chan chan struct{}
-
-	mu          sync.RWMutex
-	containerID string
-	status      server.ServerInstanceStatus
+	// dockerEvents receives the events of the instance's container from the
+	// shared eventRouter.
+	dockerEvents chan events.Message
+	eventRouter  *eventRouter
+
+	mu           sync.RWMutex
+	containerID  string
+	attachCancel context.CancelFunc
+	status       server.ServerInstanceStatus
+	statusReason string
+	// probeCancel stops the readiness probe of the current start, if any.
+	probeCancel context.CancelFunc
+
+	crashes server.CrashState
+	// restartAttempts counts the restarts made since the server last started
+	// by hand or stayed up for restartResetAfter, and restartAt is when the
+	// next is due while crashlooping.
+	restartAttempts int
+	restartAt       time.Time
 }
 
 func (dsi *dockerServerInstance) Config() server.ServerInstanceConfig {
+	dsi.mu.RLock()
+	defer dsi.mu.RUnlock()
+
 	return dsi.options
 }
 
@@ -37,12 +59,85 @@ func (dsi *dockerServerInstance) Status() server.ServerInstanceStatus {
 	return dsi.status
 }
 
+func (dsi *dockerServerInstance) Crashes() server.CrashState {
+	dsi.mu.RLock()
+	defer dsi.mu.RUnlock()
+
+	return dsi.crashes
+}
+
+func (dsi *dockerServerInstance) StatusReason() string {
+	dsi.mu.RLock()
+	defer dsi.mu.RUnlock()
+
+	return dsi.statusReason
+}
+
 func (dsi *dockerServerInstance) setStatus(status server.ServerInstanceStatus) {
 	dsi.mu.Lock()
-	defer dsi.mu.Unlock()
+	startProbe := dsi.setStatusLocked(status, "")
+	dsi.mu.Unlock()
+
+	startProbe()
+}
+
+// setErrored errors the instance, reporting the reason to the terminal.
+func (dsi *dockerServerInstance) setErrored(reason string) {
+	zerolog.Ctx(dsi.ctx).Error().Msg(reason)
+	dsi.events.TerminalOut.Dispatch(reason)
+
+	dsi.mu.Lock()
+	startProbe := dsi.setStatusLocked(server.ServerInstanceStatusErrored, reason)
+	dsi.mu.Unlock()
+
+	startProbe()
+}
+
+// swapStatus changes the status only if it's still old, so that whatever
+// changed it in the meantime isn't overridden.
+func (dsi *dockerServerInstance) swapStatus(old server.ServerInstanceStatus, status server.ServerInstanceStatus, reason string) bool {
+	dsi.mu.Lock()
+	if dsi.status != old {
+		dsi.mu.Unlock()
+		return false
+	}
+
+	startProbe := dsi.setStatusLocked(status, reason)
+	dsi.mu.Unlock()
+
+	startProbe()
+	return true
+}
+
+// setStatusLocked changes the status with the lock held. The func it returns
+// starts the readiness probe, should the server be starting, and is to be
+// called once the lock is released.
+func (dsi *dockerServerInstance) setStatusLocked(status server.ServerInstanceStatus, reason string) func() {
+	// Once closed, the instance is no longer counted.
+	if dsi.ctx.Err() == nil {
+		metrics.Instances.WithLabelValues(string(dsi.status)).Dec()
+		metrics.Instances.WithLabelValues(string(status)).Inc()
+	}
+
+	// Each start is probed afresh, and the probe is dropped once it's over.
+	if dsi.probeCancel != nil && status != server.ServerInstanceStatusStarting {
+		dsi.probeCancel()
+		dsi.probeCancel = nil
+	}
+	startProbe := func() {}
+	if status == server.ServerInstanceStatusStarting && dsi.probeCancel == nil && dsi.options.ReadinessProbe != nil {
+		ctx, ctxCancel := context.WithCancel(dsi.ctx)
+		probe := *dsi.options.ReadinessProbe
+
+		dsi.probeCancel = ctxCancel
+		startProbe = func() { dsi.startProbe(ctx, probe) }
+	}
 
 	dsi.status = status
+	dsi.statusReason = reason
 	dsi.events.Status.Dispatch(status)
+
+	return startProbe
 }
 
 func (dsi *dockerServerInstance) Events() *server.ServerInstanceEvents {
@@ -50,8 +145,13 @@ func (dsi *dockerServerInstance) Events() *server.ServerInstanceEvents {
 }
 
 func (dsi *dockerServerInstance) Close() {
+	dsi.eventRouter.remove(dsi)
 	dsi.ctxCancel()
 	<-dsi.ctxCancelDone
+	dsi.events.Close()
+
+	containerStats.remove(dsi)
+	metrics.Instances.WithLabelValues(string(dsi.Status())).Dec()
 }
 
 func NewInstance(ctx context.Context, options *DockerServerInstanceOptions) *dockerServerInstance {
@@ -67,19 +167,26 @@ func NewInstance(ctx context.Context, options *DockerServerInstanceOptions) *doc
 		events:  server.NewServerInstanceEvents(),
 		options: options,
 
-		actionChan: make(chan chan struct{}),
+		actionChan:   make(chan chan struct{}),
+		dockerEvents: make(chan events.Message, instanceEventsBuffer),
+		eventRouter:  eventRouterFromContext(ctx),
 
 		mu:          sync.RWMutex{},
 		containerID: "",
 		status:      server.ServerInstanceStatusInitializing,
 	}
 
+	metrics.Instances.WithLabelValues(string(instance.status)).Inc()
+	containerStats.add(instance)
+	instance.eventRouter.add(instance)
+
 	go instance.lifecycle()
 	go func() {
+		start := time.Now()
 		containerID, err := instance.lifecycleInit(ctx)
+		observeAction("init", start, &err)
 		if err != nil {
-			zerolog.Ctx(ctx).Err(err).Msg("Failed to initialize instance")
-			instance.setStatus(server.ServerInstanceStatusErrored)
+			instance.setErrored(fmt.Sprintf("Failed to initialize: %s", err))
 			return
 		}
 
@@ -87,7 +194,7 @@ func NewInstance(ctx context.Context, options *DockerServerInstanceOptions) *doc
 		instance.containerID = containerID
 		instance.mu.Unlock()
 
-		instance.lifecycleInitAttach()
+		instance.lifecycleAttach(containerID)
 	}()
 
 	return instance
@@ -10,32 +10,54 @@ import (
 	"strings"
 	"time"
 
+	"oppossome/serverpouch/internal/common/metrics"
 	"oppossome/serverpouch/internal/domain/server"
 
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
 // MARK: lifecycle
 
+// reconcileInterval is how often the status is refreshed without any events,
+// in case they were missed.
+const reconcileInterval = 5 * time.Minute
+
 func (dsi *dockerServerInstance) lifecycle() {
 	defer func() { dsi.ctxCancelDone <- struct{}{} }()
 
 	actionChan := dsi.actionChan
 	var actionDone chan struct{}
+	var pending []events.Message
 	defer func() {
 		if actionDone != nil {
 			<-actionDone
 		}
 	}()
 
+	// Should any events be missed, the status is still corrected eventually
+	reconcile := time.NewTicker(reconcileInterval)
+	defer reconcile.Stop()
+
 	for {
+		// Restart a crashlooping server once it's due, unless we're busy.
+		var restart <-chan time.Time
+		if restartAt, ok := dsi.restartDue(); ok && actionDone == nil {
+			restart = time.After(time.Until(restartAt))
+		}
+
 		select {
 		case <-dsi.ctx.Done():
 			return
 
+		case <-restart:
+			dsi.lifecycleRestart()
+
 		// Block new actions while we're working
 		case actionDone = <-actionChan:
 			actionChan = nil
@@ -45,8 +67,21 @@ func (dsi *dockerServerInstance) lifecycle() {
 			actionChan = dsi.actionChan
 			actionDone = nil
 
-		// If we're not busy, update our status
-		case <-time.After(time.Second * 30):
+			if len(pending) > 0 {
+				dsi.lifecycleEvent(pending...)
+				pending = nil
+			}
+
+		// Events that arrive while we're busy wait for the action, as it may
+		// have caused them, or arrived after the action refreshed the status.
+		case msg := <-dsi.dockerEvents:
+			if actionDone != nil {
+				pending = append(pending, msg)
+			} else {
+				dsi.lifecycleEvent(msg)
+			}
+
+		case <-reconcile.C:
 			if actionDone == nil {
 				dsi.lifecycleActionUpdateStatus()
 			}
@@ -79,8 +114,11 @@ func (dsi *dockerServerInstance) lifecycleActionUpdateStatus() {
 	containerID := dsi.containerID
 	dsi.mu.RUnlock()
 
+	// Without a container we're either still initializing, or failed to.
 	if containerID == "" {
-		dsi.setStatus(server.ServerInstanceStatusInitializing)
+		if dsi.Status() != server.ServerInstanceStatusErrored {
+			dsi.setStatus(server.ServerInstanceStatusInitializing)
+		}
 		return
 	}
 
@@ -93,16 +131,150 @@ func (dsi *dockerServerInstance) lifecycleActionUpdateStatus() {
 
 	switch {
 	case inspect.State.Status == "created":
-		fallthrough
-	case inspect.State.Status == "exited":
 		dsi.setStatus(server.ServerInstanceStatusIdle)
+	case inspect.State.Status == "exited":
+		switch dsi.Status() {
+		// We didn't stop it, so it exited on its own.
+		case server.ServerInstanceStatusStarting, server.ServerInstanceStatusRunning:
+			dsi.lifecycleExited(inspect.State)
+		// Either waiting to be restarted, or given up on.
+		case server.ServerInstanceStatusCrashlooping, server.ServerInstanceStatusErrored:
+		default:
+			dsi.setStatus(server.ServerInstanceStatusIdle)
+		}
 	case inspect.State.Status == "running":
-		dsi.setStatus(server.ServerInstanceStatusRunning)
+		dsi.mu.RLock()
+		probe := dsi.options.ReadinessProbe
+		dsi.mu.RUnlock()
+
+		switch dsi.Status() {
+		// With a readiness probe, it's the probe which decides when it's running.
+		// Otherwise the image's own health check decides, if it has one.
+		case server.ServerInstanceStatusStarting:
+			health := inspect.State.Health
+			if probe == nil && (health == nil || health.Status != types.Starting) {
+				dsi.setStatus(server.ServerInstanceStatusRunning)
+			}
+		// Such as by failing its probe, which still stands.
+		case server.ServerInstanceStatusErrored:
+		default:
+			dsi.setStatus(server.ServerInstanceStatusRunning)
+		}
+	default:
+		dsi.setErrored(fmt.Sprintf("Unknown docker status: %s", inspect.State.Status))
+	}
+}
+
+// MARK: lifecycleExited
+
+const (
+	// Restarts back off exponentially from restartBackoffMin to
+	// restartBackoffMax.
+	restartBackoffMin = 5 * time.Second
+	restartBackoffMax = 5 * time.Minute
+
+	// A server which stayed up this long before exiting is considered to have
+	// recovered, so its restarts start over.
+	restartResetAfter = 10 * time.Minute
+)
+
+func restartBackoff(attempt int) time.Duration {
+	backoff := restartBackoffMin
+	for range attempt {
+		backoff *= 2
+		if backoff >= restartBackoffMax {
+			return restartBackoffMax
+		}
+	}
+
+	return backoff
+}
+
+// lifecycleExited records a container exiting on its own, and decides by the
+// restart policy whether it's to be restarted, left idle, or given up on.
+func (dsi *dockerServerInstance) lifecycleExited(state *types.ContainerState) {
+	startedAt, _ := time.Parse(time.RFC3339Nano, state.StartedAt)
+	finishedAt, err := time.Parse(time.RFC3339Nano, state.FinishedAt)
+	if err != nil {
+		finishedAt = time.Now()
+	}
+
+	dsi.mu.Lock()
+	policy := dsi.options.RestartPolicy
+
+	dsi.crashes.ExitCode = state.ExitCode
+	dsi.crashes.LastExit = finishedAt
+	if state.ExitCode != 0 {
+		dsi.crashes.Count++
+		dsi.crashes.LastCrash = finishedAt
+	}
+
+	if finishedAt.Sub(startedAt) >= restartResetAfter {
+		dsi.restartAttempts = 0
+	}
+
+	attempt := dsi.restartAttempts
+	exhausted := policy.MaxRetries > 0 && attempt >= policy.MaxRetries
+	restart := policy.ShouldRestart(state.ExitCode) && !exhausted
+	if restart {
+		dsi.restartAttempts++
+		dsi.restartAt = time.Now().Add(restartBackoff(attempt))
+	}
+	dsi.mu.Unlock()
+
+	zerolog.Ctx(dsi.ctx).Warn().Msgf("Container exited with code %d", state.ExitCode)
+	dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Server exited with code %d", state.ExitCode))
+
+	switch {
+	case restart:
+		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Restarting in %s", restartBackoff(attempt)))
+		dsi.setStatus(server.ServerInstanceStatusCrashlooping)
+	case policy.ShouldRestart(state.ExitCode):
+		dsi.setErrored(fmt.Sprintf("Gave up restarting after %d attempts", attempt))
 	default:
-		zerolog.Ctx(dsi.ctx).Error().Msgf("Unknown docker status: %s", inspect.State.Status)
-		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unknown docker status: %s", inspect.State.Status))
-		dsi.setStatus(server.ServerInstanceStatusErrored)
+		dsi.setStatus(server.ServerInstanceStatusIdle)
+	}
+}
+
+// restartDue returns when the next restart is due, if the server is waiting
+// on one.
+func (dsi *dockerServerInstance) restartDue() (time.Time, bool) {
+	dsi.mu.RLock()
+	defer dsi.mu.RUnlock()
+
+	return dsi.restartAt, dsi.status == server.ServerInstanceStatusCrashlooping
+}
+
+// MARK: lifecycleRestart
+
+// lifecycleRestart starts a crashlooping server back up. It's only called by
+// the lifecycle while it isn't busy, so it doesn't acquire an action.
+func (dsi *dockerServerInstance) lifecycleRestart() {
+	// The server may have been stopped or started by hand in the meantime.
+	if dsi.Status() != server.ServerInstanceStatusCrashlooping {
+		return
+	}
+
+	var err error
+	defer observeAction("autorestart", time.Now(), &err)
+
+	dsi.mu.RLock()
+	containerID := dsi.containerID
+	attempt := dsi.restartAttempts
+	dsi.mu.RUnlock()
+
+	zerolog.Ctx(dsi.ctx).Info().Msgf("Restarting container, attempt %d", attempt)
+	dsi.setStatus(server.ServerInstanceStatusStarting)
+	dsi.lifecycleAttach(containerID)
+
+	err = dsi.client.ContainerStart(dsi.ctx, containerID, container.StartOptions{})
+	if err != nil {
+		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to start container: %s", err)
+		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to start container: %s", err))
 	}
+
+	// Should it have failed to start, this counts it as another crash.
+	dsi.lifecycleActionUpdateStatus()
 }
 
 // MARK: lifecycleInit
@@ -124,25 +296,72 @@ func (dsi *dockerServerInstance) lifecycleInit(ctx context.Context) (string, err
 	}
 	defer actionDone()
 
-	containers, err := dsi.client.ContainerList(dsi.ctx, container.ListOptions{All: true})
+	// Check if we have the container already.
+	container, err := dsi.lifecycleFindContainer(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if container != nil {
+		// Docker lists the image's ID instead once its tag has moved on to a
+		// newer image, which is still the image we asked for.
+		if container.Image != dsi.options.Image && !strings.HasPrefix(container.Image, "sha256:") {
+			return dsi.lifecycleReplaceContainer(ctx, container)
+		}
+
+		// Containers created before we labelled them aren't sent any events, so
+		// only catch up with the occasional reconcile until they're recreated.
+		if _, ok := container.Labels[instanceLabel]; !ok {
+			zerolog.Ctx(ctx).Warn().Msgf("Container \"%s\" is unlabelled, recreate it to follow its events", container.ID)
+		}
+
+		zerolog.Ctx(ctx).Info().Msgf("Found container \"%s\"", container.ID)
+		return container.ID, nil
+	}
+
+	return dsi.lifecycleCreateContainer(ctx)
+}
+
+// lifecycleReplaceContainer recreates a container left behind with another
+// image, such as by an update which failed part way. Its volumes are kept.
+func (dsi *dockerServerInstance) lifecycleReplaceContainer(ctx context.Context, existing *types.Container) (string, error) {
+	zerolog.Ctx(ctx).Warn().Msgf("Found container \"%s\" with non-matching image \"%s\", recreating it", existing.ID, existing.Image)
+	dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Recreating container from \"%s\" as it was using \"%s\"", dsi.options.Image, existing.Image))
+
+	err := dsi.client.ContainerRemove(ctx, existing.ID, container.RemoveOptions{Force: true})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("Unable to remove container: %s", err)
+		return "", errors.Errorf("Found non-matching container image \"%s\", and was unable to remove it: %s", existing.Image, err)
+	}
+
+	return dsi.lifecycleCreateContainer(ctx)
+}
+
+// MARK: lifecycleFindContainer
+
+// lifecycleFindContainer looks up the container named after this instance,
+// returning nil if it doesn't exist.
+func (dsi *dockerServerInstance) lifecycleFindContainer(ctx context.Context) (*types.Container, error) {
+	containers, err := dsi.client.ContainerList(ctx, container.ListOptions{All: true})
 	if err != nil {
 		zerolog.Ctx(ctx).Error().Msg("Unable to list containers")
-		return "", errors.Wrap(err, "Unable to list containers")
+		return nil, errors.Wrap(err, "Unable to list containers")
 	}
 
-	// Check if we have the container already.
 	for _, container := range containers {
 		if slices.Contains(container.Names, "/"+dsi.options.InstanceID.String()) {
-			if container.Image != dsi.options.Image {
-				zerolog.Ctx(ctx).Error().Msgf("Found non-matching container image: %s", container.Image)
-				return "", errors.Wrapf(err, "Found non-matching container image: %s", container.Image)
-			}
-
-			zerolog.Ctx(ctx).Info().Msgf("Found container \"%s\"", container.ID)
-			return container.ID, nil
+			return &container, nil
 		}
 	}
 
+	return nil, nil
+}
+
+// MARK: lifecycleCreateContainer
+
+// lifecycleCreateContainer creates the container from the instance's current
+// options, pulling the image first if it's not available.
+func (dsi *dockerServerInstance) lifecycleCreateContainer(ctx context.Context) (string, error) {
 	// Check if we have the image already.
 	images, err := dsi.client.ImageList(ctx, image.ListOptions{All: true})
 	if err != nil {
@@ -161,40 +380,9 @@ func (dsi *dockerServerInstance) lifecycleInit(ctx context.Context) (string, err
 
 	// Since we couldn't find the image, we'll pull it.
 	if !foundImage {
-		zerolog.Ctx(ctx).Info().Msgf("Pulling image \"%s\"", dsi.options.Image)
-		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Pulling image \"%s\"", dsi.options.Image))
-
-		reader, err := dsi.client.ImagePull(ctx, dsi.options.Image, image.PullOptions{})
-		if err != nil {
-			zerolog.Ctx(ctx).Error().Msgf("Failed to pull image \"%s\"", dsi.options.Image)
-			return "", errors.Wrapf(err, "Failed to pull image \"%s\"", dsi.options.Image)
-		}
-		defer reader.Close()
-
-		decoder := json.NewDecoder(reader)
-		for {
-			var pullEvent dockerEvent
-			if err := decoder.Decode(&pullEvent); err != nil {
-				if err == io.EOF {
-					break
-				}
-
-				zerolog.Ctx(ctx).Error().Msg("Failed to decode pull progress")
-				return "", errors.Wrap(err, "Failed to decode pull progress")
-			}
-
-			if pullEvent.Error != "" {
-				zerolog.Ctx(ctx).Error().Msgf("Pull errored: %s", pullEvent.Error)
-				return "", errors.Errorf("Pull errored: %s", pullEvent.Error)
-			}
-
-			if pullEvent.Status != "" {
-				zerolog.Ctx(ctx).Info().Msgf("[Docker] %s", pullEvent.Status)
-				dsi.events.TerminalOut.Dispatch(fmt.Sprintf("[Docker] %s", pullEvent.Status))
-			}
+		if err := dsi.lifecyclePullImage(ctx); err != nil {
+			return "", err
 		}
-
-		zerolog.Ctx(ctx).Info().Msgf("Pulled image \"%s\"", dsi.options.Image)
 	}
 
 	// Create the container.
@@ -209,45 +397,121 @@ func (dsi *dockerServerInstance) lifecycleInit(ctx context.Context) (string, err
 	return container.ID, nil
 }
 
-// MARK: lifecycleInitAttach
+// MARK: lifecyclePullImage
+
+// lifecyclePullImage pulls the instance's image, relaying docker's progress to
+// the terminal.
+func (dsi *dockerServerInstance) lifecyclePullImage(ctx context.Context) error {
+	zerolog.Ctx(ctx).Info().Msgf("Pulling image \"%s\"", dsi.options.Image)
+	dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Pulling image \"%s\"", dsi.options.Image))
+	pullStart := time.Now()
+
+	reader, err := dsi.client.ImagePull(ctx, dsi.options.Image, image.PullOptions{})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Msgf("Failed to pull image \"%s\"", dsi.options.Image)
+		return errors.Wrapf(err, "Failed to pull image \"%s\"", dsi.options.Image)
+	}
+	defer reader.Close()
+
+	decoder := json.NewDecoder(reader)
+	for {
+		var pullEvent dockerEvent
+		if err := decoder.Decode(&pullEvent); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			zerolog.Ctx(ctx).Error().Msg("Failed to decode pull progress")
+			return errors.Wrap(err, "Failed to decode pull progress")
+		}
+
+		if pullEvent.Error != "" {
+			zerolog.Ctx(ctx).Error().Msgf("Pull errored: %s", pullEvent.Error)
+			return errors.Errorf("Pull errored: %s", pullEvent.Error)
+		}
+
+		if pullEvent.Status != "" {
+			zerolog.Ctx(ctx).Info().Msgf("[Docker] %s", pullEvent.Status)
+			dsi.events.TerminalOut.Dispatch(fmt.Sprintf("[Docker] %s", pullEvent.Status))
+		}
+	}
+
+	metrics.ImagePullDuration.Observe(time.Since(pullStart).Seconds())
+	zerolog.Ctx(ctx).Info().Msgf("Pulled image \"%s\"", dsi.options.Image)
+
+	return nil
+}
+
+// MARK: lifecycleAttach
+
+// lifecycleAttach attaches to the container's stdio, replacing any previous
+// attachment. Docker closes attachments once a container exits, so this is
+// called before every start to keep the terminal events flowing.
+func (dsi *dockerServerInstance) lifecycleAttach(containerID string) {
+	ctx, ctxCancel := context.WithCancel(dsi.ctx)
 
-func (dsi *dockerServerInstance) lifecycleInitAttach() {
-	attach, err := dsi.client.ContainerAttach(dsi.ctx, dsi.containerID, container.AttachOptions{
+	dsi.mu.Lock()
+	if dsi.attachCancel != nil {
+		dsi.attachCancel()
+	}
+	dsi.attachCancel = ctxCancel
+	dsi.mu.Unlock()
+
+	attach, err := dsi.client.ContainerAttach(ctx, containerID, container.AttachOptions{
 		Stream: true,
 		Stdin:  true,
 		Stdout: true,
 		Stderr: true,
 	})
 	if err != nil {
+		ctxCancel()
 		zerolog.Ctx(dsi.ctx).Error().Msgf("Unable to attach to container: %s", err)
 		dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Unable to attach to container: %s", err))
 		return
 	}
+
+	// Subscribe before returning so that no input sent after attaching is lost.
+	termInChan := dsi.events.TerminalIn.On()
+	go dsi.lifecycleAttachStream(ctx, ctxCancel, attach, termInChan)
+}
+
+func (dsi *dockerServerInstance) lifecycleAttachStream(ctx context.Context, ctxCancel context.CancelFunc, attach types.HijackedResponse, termInChan <-chan string) {
 	defer attach.Close()
+	defer dsi.events.TerminalIn.Off(termInChan)
 
 	go func() {
-		scanner := bufio.NewScanner(attach.Reader)
+		defer ctxCancel()
+
+		// We never allocate a TTY, so stdout and stderr arrive multiplexed.
+		output, outputWriter := io.Pipe()
+		go func() {
+			_, err := stdcopy.StdCopy(outputWriter, outputWriter, attach.Reader)
+			outputWriter.CloseWithError(err)
+		}()
+
+		scanner := bufio.NewScanner(output)
 		for scanner.Scan() {
 			dsi.events.TerminalOut.Dispatch(scanner.Text())
 		}
 	}()
 
-	termInChan := dsi.events.TerminalIn.On()
-	defer dsi.events.TerminalIn.Off(termInChan)
-
 	for {
 		select {
-		case <-dsi.ctx.Done():
+		case <-ctx.Done():
 			return
-		case termIn := <-termInChan:
+		case termIn, ok := <-termInChan:
+			if !ok {
+				return
+			}
+
 			if !strings.HasSuffix(termIn, "\n") {
 				termIn += "\n"
 			}
 
-			zerolog.Ctx(dsi.ctx).Debug().Msgf("Executing command: %s", termIn)
+			zerolog.Ctx(ctx).Debug().Msgf("Executing command: %s", termIn)
 			_, err := attach.Conn.Write([]byte(termIn))
 			if err != nil {
-				zerolog.Ctx(dsi.ctx).Error().Msgf("Error writing to container: %s", err)
+				zerolog.Ctx(ctx).Error().Msgf("Error writing to container: %s", err)
 				dsi.events.TerminalOut.Dispatch(fmt.Sprintf("Error writing to container: %s", err))
 				return
 			}
@@ -1,8 +1,10 @@
 package docker
 
 import (
+	"bufio"
 	"context"
 	"io"
+	"net"
 	"strings"
 	"sync"
 	"testing"
@@ -13,11 +15,14 @@ import (
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/google/uuid"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // MARK: Helpers
@@ -35,7 +40,9 @@ func testDockerServerInstance(t *testing.T, options *DockerServerInstanceOptions
 		events:  server.NewServerInstanceEvents(),
 		options: options,
 
-		actionChan: make(chan chan struct{}),
+		actionChan:   make(chan chan struct{}),
+		dockerEvents: make(chan events.Message, instanceEventsBuffer),
+		eventRouter:  newEventRouter(testCtx, mockAPIClient),
 
 		mu:          sync.RWMutex{},
 		containerID: "",
@@ -43,6 +50,18 @@ func testDockerServerInstance(t *testing.T, options *DockerServerInstanceOptions
 	}
 }
 
+// testHijackedResponse returns an attachment backed by an in-memory pipe,
+// alongside the container's end of it.
+func testHijackedResponse(t *testing.T) (types.HijackedResponse, net.Conn) {
+	clientConn, containerConn := net.Pipe()
+	t.Cleanup(func() {
+		clientConn.Close()
+		containerConn.Close()
+	})
+
+	return types.NewHijackedResponse(clientConn, types.MediaTypeMultiplexedStream), containerConn
+}
+
 func assertTerminalOut(t *testing.T, dsi *dockerServerInstance, done chan<- struct{}, expected []string) {
 	termOut := dsi.events.TerminalOut.On()
 
@@ -79,7 +98,14 @@ func TestLifecycle(t *testing.T) {
 		dsi.containerID = uuid.Nil.String()
 		dsi.status = server.ServerInstanceStatusIdle
 
-		// First we will start the container
+		// First we will attach to and start the container
+		attach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			dsi.containerID,
+			mock.Anything,
+		).Return(attach, nil).Once()
+
 		mockClient.EXPECT().ContainerStart(
 			dsi.ctx,
 			dsi.containerID,
@@ -140,6 +166,56 @@ func TestLifecycle(t *testing.T) {
 		assert.Equal(t, <-statusChan, server.ServerInstanceStatusIdle)
 	})
 
+	t.Run("Ok - Handles events that arrive mid-action", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusIdle
+
+		attach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			dsi.containerID,
+			mock.Anything,
+		).Return(attach, nil).Once()
+
+		// The container is removed behind our back while it's being started
+		mockClient.EXPECT().ContainerStart(
+			dsi.ctx,
+			dsi.containerID,
+			container.StartOptions{},
+		).RunAndReturn(func(context.Context, string, container.StartOptions) error {
+			dsi.dockerEvents <- events.Message{Action: events.ActionDestroy, Actor: events.Actor{ID: uuid.Nil.String()}}
+			return nil
+		}).Once()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+			},
+			nil,
+		).Once()
+
+		statusChan := dsi.events.Status.On()
+		defer dsi.Events().Status.Off(statusChan)
+
+		go dsi.lifecycle()
+		go dsi.Start()
+
+		assert.Equal(t, server.ServerInstanceStatusStarting, <-statusChan)
+		assert.Equal(t, server.ServerInstanceStatusRunning, <-statusChan)
+		assert.Equal(t, server.ServerInstanceStatusErrored, <-statusChan)
+		assert.Equal(t, "Container was removed outside of serverpouch", dsi.StatusReason())
+	})
+
 	t.Run("Edgecase - Shutting down mid-action", func(t *testing.T) {
 		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
 			InstanceID: uuid.New(),
@@ -150,6 +226,13 @@ func TestLifecycle(t *testing.T) {
 		dsi.status = server.ServerInstanceStatusIdle
 
 		// The action we will shutdown during
+		attach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			dsi.containerID,
+			mock.Anything,
+		).Return(attach, nil).Once()
+
 		mockClient.EXPECT().ContainerStart(
 			dsi.ctx,
 			dsi.containerID,
@@ -259,6 +342,191 @@ func TestLifecycleActionUpdateStatus(t *testing.T) {
 	}
 }
 
+// MARK: - lifecycleExited
+
+func TestLifecycleExited(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		policy          server.RestartPolicy
+		exitCode        int
+		uptime          time.Duration
+		attempts        int
+		expected        server.ServerInstanceStatus
+		expectedCount   int
+		expectedAttempt int
+	}{
+		{
+			name:          "Ok - Never restarts",
+			policy:        server.RestartPolicy{},
+			exitCode:      1,
+			expected:      server.ServerInstanceStatusIdle,
+			expectedCount: 1,
+		},
+		{
+			name:            "Ok - Restarts on failure",
+			policy:          server.RestartPolicy{Mode: server.RestartModeOnFailure},
+			exitCode:        1,
+			expected:        server.ServerInstanceStatusCrashlooping,
+			expectedCount:   1,
+			expectedAttempt: 1,
+		},
+		{
+			name:     "Ok - Clean exits aren't failures",
+			policy:   server.RestartPolicy{Mode: server.RestartModeOnFailure},
+			exitCode: 0,
+			expected: server.ServerInstanceStatusIdle,
+		},
+		{
+			name:            "Ok - Always restarts",
+			policy:          server.RestartPolicy{Mode: server.RestartModeAlways},
+			exitCode:        0,
+			expected:        server.ServerInstanceStatusCrashlooping,
+			expectedAttempt: 1,
+		},
+		{
+			name:            "Ok - Gives up once out of retries",
+			policy:          server.RestartPolicy{Mode: server.RestartModeOnFailure, MaxRetries: 3},
+			exitCode:        137,
+			attempts:        3,
+			expected:        server.ServerInstanceStatusErrored,
+			expectedCount:   1,
+			expectedAttempt: 3,
+		},
+		{
+			name:            "Ok - Retries start over after staying up",
+			policy:          server.RestartPolicy{Mode: server.RestartModeOnFailure, MaxRetries: 3},
+			exitCode:        1,
+			uptime:          time.Hour,
+			attempts:        3,
+			expected:        server.ServerInstanceStatusCrashlooping,
+			expectedCount:   1,
+			expectedAttempt: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+				InstanceID:    uuid.New(),
+				Image:         "Test",
+				RestartPolicy: tt.policy,
+			})
+
+			dsi.containerID = uuid.Nil.String()
+			dsi.status = server.ServerInstanceStatusRunning
+			dsi.restartAttempts = tt.attempts
+
+			finishedAt := time.Now()
+			dsi.lifecycleExited(&types.ContainerState{
+				Status:     "exited",
+				ExitCode:   tt.exitCode,
+				StartedAt:  finishedAt.Add(-tt.uptime).Format(time.RFC3339Nano),
+				FinishedAt: finishedAt.Format(time.RFC3339Nano),
+			})
+
+			assert.Equal(t, tt.expected, dsi.Status())
+			assert.Equal(t, tt.exitCode, dsi.Crashes().ExitCode)
+			assert.Equal(t, tt.expectedCount, dsi.Crashes().Count)
+			assert.Equal(t, tt.expectedAttempt, dsi.restartAttempts)
+		})
+	}
+}
+
+func TestRestartBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, restartBackoff(0))
+	assert.Equal(t, 10*time.Second, restartBackoff(1))
+	assert.Equal(t, 80*time.Second, restartBackoff(4))
+	assert.Equal(t, 5*time.Minute, restartBackoff(10))
+}
+
+func TestLifecycleRestart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Restarts a crashlooping server once due", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID:    uuid.New(),
+			Image:         "Test",
+			RestartPolicy: server.RestartPolicy{Mode: server.RestartModeOnFailure},
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusCrashlooping
+		dsi.restartAt = time.Now()
+
+		attach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			dsi.containerID,
+			mock.Anything,
+		).Return(attach, nil).Once()
+
+		mockClient.EXPECT().ContainerStart(
+			dsi.ctx,
+			dsi.containerID,
+			container.StartOptions{},
+		).Return(nil).Once()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+				Mounts:          []types.MountPoint{},
+				Config:          &container.Config{},
+				NetworkSettings: &types.NetworkSettings{},
+			},
+			nil,
+		).Once()
+
+		statusChan := dsi.events.Status.On()
+		defer dsi.Events().Status.Off(statusChan)
+
+		go dsi.lifecycle()
+
+		assert.Equal(t, server.ServerInstanceStatusStarting, <-statusChan)
+		assert.Equal(t, server.ServerInstanceStatusRunning, <-statusChan)
+	})
+
+	t.Run("Ok - Stopping cancels the restart", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		dsi.containerID = uuid.Nil.String()
+		dsi.status = server.ServerInstanceStatusCrashlooping
+		dsi.restartAt = time.Now().Add(time.Hour)
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "exited", ExitCode: 1},
+				},
+				Mounts:          []types.MountPoint{},
+				Config:          &container.Config{},
+				NetworkSettings: &types.NetworkSettings{},
+			},
+			nil,
+		).Once()
+
+		go dsi.lifecycle()
+		assert.NoError(t, dsi.Stop())
+		assert.Equal(t, server.ServerInstanceStatusIdle, dsi.Status())
+
+		// The restart isn't due, but wouldn't be made for an idle server anyway.
+		_, due := dsi.restartDue()
+		assert.False(t, due)
+	})
+}
+
 // MARK: - lifecycleInit
 
 func TestLifecycleInit(t *testing.T) {
@@ -290,6 +558,90 @@ func TestLifecycleInit(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Ok - Recreates a container with another image", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		// First, it finds a container left behind with the old image
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:    uuid.Nil.String(),
+				Image: "Old",
+				Names: []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		// Second, it removes it, keeping its volumes
+		mockClient.EXPECT().ContainerRemove(
+			dsi.ctx,
+			uuid.Nil.String(),
+			container.RemoveOptions{Force: true},
+		).Return(nil).Once()
+
+		// Finally, it creates it again from the image it should have
+		mockClient.EXPECT().ImageList(
+			dsi.ctx,
+			image.ListOptions{All: true},
+		).Return(
+			[]image.Summary{{
+				Labels: map[string]string{
+					"org.opencontainers.image.ref.name": dsi.options.Image,
+				},
+			}},
+			nil,
+		).Once()
+
+		opts, hostOpts := dsi.options.toOptions()
+		mockClient.EXPECT().ContainerCreate(
+			dsi.ctx,
+			opts,
+			hostOpts,
+			(*network.NetworkingConfig)(nil),
+			(*v1.Platform)(nil),
+			dsi.options.InstanceID.String(),
+		).Return(
+			container.CreateResponse{ID: "recreated"},
+			nil,
+		).Once()
+
+		go dsi.lifecycle()
+		containerID, err := dsi.lifecycleInit(dsi.ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "recreated", containerID)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Ok - Keeps a container whose tag has moved on", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		// Docker lists the image's ID once the tag points elsewhere.
+		mockClient.EXPECT().ContainerList(
+			dsi.ctx,
+			container.ListOptions{All: true},
+		).Return(
+			[]types.Container{{
+				ID:    uuid.Nil.String(),
+				Image: "sha256:4a2b0c9e8f1d",
+				Names: []string{"/" + dsi.options.InstanceID.String()},
+			}},
+			nil,
+		).Once()
+
+		go dsi.lifecycle()
+		containerID, err := dsi.lifecycleInit(dsi.ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil.String(), containerID)
+	})
+
 	t.Run("Ok - Creates container after finding image", func(*testing.T) {
 		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
 			InstanceID: uuid.New(),
@@ -406,3 +758,74 @@ func TestLifecycleInit(t *testing.T) {
 		<-done
 	})
 }
+
+// MARK: - lifecycleAttach
+
+func TestLifecycleAttach(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - Bridges the terminal events", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		attach, containerConn := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			uuid.Nil.String(),
+			container.AttachOptions{
+				Stream: true,
+				Stdin:  true,
+				Stdout: true,
+				Stderr: true,
+			},
+		).Return(attach, nil).Once()
+
+		done := make(chan struct{})
+		assertTerminalOut(t, dsi, done, []string{"Hello from stdout", "Hello from stderr"})
+
+		dsi.lifecycleAttach(uuid.Nil.String())
+
+		// Output is demultiplexed into separate lines
+		_, err := stdcopy.NewStdWriter(containerConn, stdcopy.Stdout).Write([]byte("Hello from stdout\n"))
+		assert.NoError(t, err)
+		_, err = stdcopy.NewStdWriter(containerConn, stdcopy.Stderr).Write([]byte("Hello from stderr\n"))
+		assert.NoError(t, err)
+		<-done
+
+		// Input is written to the container with a trailing newline
+		go dsi.events.TerminalIn.Dispatch("help")
+		line, err := bufio.NewReader(containerConn).ReadString('\n')
+		assert.NoError(t, err)
+		assert.Equal(t, "help\n", line)
+	})
+
+	t.Run("Ok - Replaces the previous attachment", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID: uuid.New(),
+			Image:      "Test",
+		})
+
+		firstAttach, firstConn := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			uuid.Nil.String(),
+			mock.Anything,
+		).Return(firstAttach, nil).Once()
+
+		secondAttach, _ := testHijackedResponse(t)
+		mockClient.EXPECT().ContainerAttach(
+			mock.Anything,
+			uuid.Nil.String(),
+			mock.Anything,
+		).Return(secondAttach, nil).Once()
+
+		dsi.lifecycleAttach(uuid.Nil.String())
+		dsi.lifecycleAttach(uuid.Nil.String())
+
+		// The first attachment's connection is closed once it's replaced
+		_, err := firstConn.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
@@ -0,0 +1,216 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/pkg/errors"
+)
+
+// MARK: Logs
+
+func (dsi *dockerServerInstance) Logs(ctx context.Context, opts server.LogOptions) (server.LogReader, error) {
+	dsi.mu.RLock()
+	containerID := dsi.containerID
+	dsi.mu.RUnlock()
+
+	if containerID == "" {
+		return nil, &server.InvalidStatusError{Action: "Logs", Status: dsi.Status()}
+	}
+
+	// Timestamps are always requested, and split off each line as it's read.
+	logsOpts := container.LogsOptions{
+		ShowStdout: opts.Stdout,
+		ShowStderr: opts.Stderr,
+		Timestamps: true,
+		Follow:     opts.Follow,
+		Tail:       "all",
+	}
+	if !opts.Since.IsZero() {
+		logsOpts.Since = dockerTimestamp(opts.Since)
+	}
+	if !opts.Until.IsZero() {
+		logsOpts.Until = dockerTimestamp(opts.Until)
+	}
+	if opts.Tail != nil {
+		logsOpts.Tail = strconv.Itoa(*opts.Tail)
+	}
+
+	reader, err := dsi.client.ContainerLogs(ctx, containerID, logsOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read container logs")
+	}
+
+	return &dockerLogReader{reader: reader, timestamps: true}, nil
+}
+
+// dockerTimestamp formats the time as the seconds since the epoch, which is
+// what docker expects for since and until.
+func dockerTimestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
+
+// MARK: dockerLogReader
+
+const (
+	// maxLogLineSize caps how much of a line is buffered while waiting for its
+	// newline. Longer lines are split, so that output without any newlines
+	// can't take up unbounded memory.
+	maxLogLineSize = 64 * 1024
+
+	// logChunkSize is how much of a frame is read at a time, as frames may be
+	// as large as the container cares to make them.
+	logChunkSize = 32 * 1024
+)
+
+// dockerLogReader splits the container's logs into lines. We never allocate a
+// TTY, so stdout and stderr arrive multiplexed into frames, which are read one
+// at a time so that lines keep their order across both streams.
+type dockerLogReader struct {
+	reader io.ReadCloser
+	header [8]byte
+	chunk  [logChunkSize]byte
+	// timestamps is set when docker prefixes each line with the time it was
+	// printed.
+	timestamps bool
+
+	stdout  []byte
+	stderr  []byte
+	pending []server.LogLine
+	err     error
+}
+
+var _ server.LogReader = (*dockerLogReader)(nil)
+
+func (lr *dockerLogReader) Next() (server.LogLine, error) {
+	for len(lr.pending) == 0 {
+		if lr.err != nil {
+			return server.LogLine{}, lr.err
+		}
+
+		lr.readFrame()
+	}
+
+	line := lr.pending[0]
+	lr.pending = lr.pending[1:]
+	return line, nil
+}
+
+func (lr *dockerLogReader) Close() error {
+	return lr.reader.Close()
+}
+
+func (lr *dockerLogReader) readFrame() {
+	_, err := io.ReadFull(lr.reader, lr.header[:])
+	switch {
+	case err == io.EOF:
+		// Whatever is left is a final line without a newline.
+		lr.pending = append(lr.pending, lr.splitLines(server.LogStreamStdout, &lr.stdout, true)...)
+		lr.pending = append(lr.pending, lr.splitLines(server.LogStreamStderr, &lr.stderr, true)...)
+		lr.err = io.EOF
+		return
+	case err != nil:
+		lr.err = errors.Wrap(err, "Unable to read container output")
+		return
+	}
+
+	size := int(binary.BigEndian.Uint32(lr.header[4:]))
+
+	var stream server.LogStream
+	var buf *[]byte
+	switch stdcopy.StdType(lr.header[0]) {
+	case stdcopy.Stdout:
+		stream, buf = server.LogStreamStdout, &lr.stdout
+	case stdcopy.Stderr:
+		stream, buf = server.LogStreamStderr, &lr.stderr
+	case stdcopy.Systemerr:
+		payload := lr.chunk[:min(size, len(lr.chunk))]
+		if _, err := io.ReadFull(lr.reader, payload); err != nil {
+			lr.err = errors.Wrap(err, "Unable to read container output")
+			return
+		}
+
+		lr.err = errors.Errorf("Unable to read container output: %s", payload)
+		return
+	default:
+		lr.err = errors.Errorf("Unknown output stream %d", lr.header[0])
+		return
+	}
+
+	for size > 0 {
+		chunk := lr.chunk[:min(size, len(lr.chunk))]
+		if _, err := io.ReadFull(lr.reader, chunk); err != nil {
+			lr.err = errors.Wrap(err, "Unable to read container output")
+			return
+		}
+		size -= len(chunk)
+
+		*buf = append(*buf, chunk...)
+		lr.pending = append(lr.pending, lr.splitLines(stream, buf, false)...)
+	}
+}
+
+// splitLines takes every complete line out of the buffer, or everything if
+// final is set. Lines longer than maxLogLineSize are split.
+func (lr *dockerLogReader) splitLines(stream server.LogStream, buf *[]byte, final bool) []server.LogLine {
+	lines := []server.LogLine{}
+
+	for {
+		idx := bytes.IndexByte(*buf, '\n')
+		if idx < 0 && len(*buf) < maxLogLineSize {
+			break
+		}
+
+		if idx < 0 || idx > maxLogLineSize {
+			// Split on a rune boundary, so that neither half is mangled, unless
+			// there's no rune to be found.
+			cut := maxLogLineSize
+			for cut < len(*buf) && cut > maxLogLineSize-utf8.UTFMax && !utf8.RuneStart((*buf)[cut]) {
+				cut--
+			}
+
+			lines = append(lines, parseLogLine(stream, string((*buf)[:cut]), lr.timestamps))
+			*buf = (*buf)[cut:]
+			continue
+		}
+
+		lines = append(lines, parseLogLine(stream, string((*buf)[:idx]), lr.timestamps))
+		*buf = (*buf)[idx+1:]
+	}
+
+	if final && len(*buf) > 0 {
+		lines = append(lines, parseLogLine(stream, string(*buf), lr.timestamps))
+		*buf = nil
+	}
+
+	return lines
+}
+
+// parseLogLine splits off the timestamp docker prefixes each line with, if
+// there is one.
+func parseLogLine(stream server.LogStream, line string, timestamps bool) server.LogLine {
+	logLine := server.LogLine{Stream: stream, Text: strings.TrimSuffix(line, "\r")}
+	if !timestamps {
+		return logLine
+	}
+
+	if timestamp, text, ok := strings.Cut(logLine.Text, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			logLine.Time = t
+			logLine.Text = text
+		}
+	}
+
+	return logLine
+}
@@ -0,0 +1,111 @@
+package docker
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MARK: - Logs
+
+func TestLogs(t *testing.T) {
+	t.Run("Ok - Demultiplexes in order", func(t *testing.T) {
+		mockAPIClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{InstanceID: uuid.Nil})
+		dsi.containerID = "test-container"
+
+		var logs bytes.Buffer
+		stdout := stdcopy.NewStdWriter(&logs, stdcopy.Stdout)
+		stderr := stdcopy.NewStdWriter(&logs, stdcopy.Stderr)
+		stdout.Write([]byte("2025-01-02T03:04:05.000000006Z Hello\n2025-01-02T03:04:06Z Wor"))
+		stderr.Write([]byte("2025-01-02T03:04:07Z Oops\r\n"))
+		stdout.Write([]byte("ld\n2025-01-02T03:04:08Z No newline"))
+
+		since, tail := time.Unix(1700000000, 5), 10
+		mockAPIClient.EXPECT().ContainerLogs(mock.Anything, "test-container", container.LogsOptions{
+			ShowStdout: true,
+			ShowStderr: true,
+			Timestamps: true,
+			Since:      "1700000000.000000005",
+			Tail:       "10",
+		}).Return(io.NopCloser(&logs), nil)
+
+		reader, err := dsi.Logs(t.Context(), server.LogOptions{Since: since, Tail: &tail, Stdout: true, Stderr: true})
+		assert.NoError(t, err)
+		defer reader.Close()
+
+		lines := []server.LogLine{}
+		for {
+			line, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+
+			assert.NoError(t, err)
+			lines = append(lines, line)
+		}
+
+		assert.Equal(t, []server.LogLine{
+			{Stream: server.LogStreamStdout, Time: time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC), Text: "Hello"},
+			{Stream: server.LogStreamStderr, Time: time.Date(2025, 1, 2, 3, 4, 7, 0, time.UTC), Text: "Oops"},
+			{Stream: server.LogStreamStdout, Time: time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC), Text: "World"},
+			{Stream: server.LogStreamStdout, Time: time.Date(2025, 1, 2, 3, 4, 8, 0, time.UTC), Text: "No newline"},
+		}, lines)
+	})
+
+	t.Run("Ok - A tail of 0 reads nothing", func(t *testing.T) {
+		mockAPIClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{InstanceID: uuid.Nil})
+		dsi.containerID = "test-container"
+
+		tail := 0
+		mockAPIClient.EXPECT().ContainerLogs(mock.Anything, "test-container", container.LogsOptions{
+			ShowStdout: true,
+			Timestamps: true,
+			Tail:       "0",
+		}).Return(io.NopCloser(&bytes.Buffer{}), nil)
+
+		reader, err := dsi.Logs(t.Context(), server.LogOptions{Tail: &tail, Stdout: true})
+		assert.NoError(t, err)
+		defer reader.Close()
+
+		_, err = reader.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Ok - Splits overlong lines", func(t *testing.T) {
+		var logs bytes.Buffer
+		stdout := stdcopy.NewStdWriter(&logs, stdcopy.Stdout)
+		stdout.Write([]byte(strings.Repeat("a", maxLogLineSize+10) + "\n"))
+
+		reader := &dockerLogReader{reader: io.NopCloser(&logs)}
+
+		line, err := reader.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Repeat("a", maxLogLineSize), line.Text)
+
+		line, err = reader.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Repeat("a", 10), line.Text)
+
+		_, err = reader.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Err - No container", func(t *testing.T) {
+		_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{InstanceID: uuid.Nil})
+
+		_, err := dsi.Logs(t.Context(), server.LogOptions{Stdout: true})
+
+		var statusErr *server.InvalidStatusError
+		assert.ErrorAs(t, err, &statusErr)
+	})
+}
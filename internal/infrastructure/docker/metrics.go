@@ -0,0 +1,158 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"oppossome/serverpouch/internal/common/metrics"
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+)
+
+// statsTimeout bounds how long a scrape waits on docker for each container.
+const statsTimeout = 5 * time.Second
+
+var containerStats = &statsCollector{
+	instances: map[*dockerServerInstance]struct{}{},
+
+	cpuDesc: prometheus.NewDesc(
+		"serverpouch_container_cpu_usage_seconds_total",
+		"CPU time consumed by the server's container.",
+		[]string{"server"}, nil,
+	),
+	memoryDesc: prometheus.NewDesc(
+		"serverpouch_container_memory_usage_bytes",
+		"Memory used by the server's container.",
+		[]string{"server"}, nil,
+	),
+	memoryLimitDesc: prometheus.NewDesc(
+		"serverpouch_container_memory_limit_bytes",
+		"Memory available to the server's container.",
+		[]string{"server"}, nil,
+	),
+}
+
+func init() {
+	prometheus.MustRegister(containerStats)
+
+	// Report every status, even before any instance has it.
+	for _, status := range []server.ServerInstanceStatus{
+		server.ServerInstanceStatusInitializing,
+		server.ServerInstanceStatusIdle,
+		server.ServerInstanceStatusStarting,
+		server.ServerInstanceStatusRunning,
+		server.ServerInstanceStatusStopping,
+		server.ServerInstanceStatusErrored,
+		server.ServerInstanceStatusCrashlooping,
+	} {
+		metrics.Instances.WithLabelValues(string(status))
+	}
+}
+
+// observeAction records how long an action took once it returns, counting it
+// as failed if it returned an error. Actions which report docker's errors to
+// the terminal rather than returning them count those with failAction.
+func observeAction(action string, start time.Time, err *error) {
+	metrics.LifecycleActionDuration.WithLabelValues(action).Observe(time.Since(start).Seconds())
+	if *err != nil {
+		failAction(action)
+	}
+}
+
+func failAction(action string) {
+	metrics.LifecycleActionFailures.WithLabelValues(action).Inc()
+}
+
+// MARK: statsCollector
+
+// statsCollector samples the resource usage of every running container
+// whenever the metrics are scraped.
+type statsCollector struct {
+	mu        sync.Mutex
+	instances map[*dockerServerInstance]struct{}
+
+	cpuDesc         *prometheus.Desc
+	memoryDesc      *prometheus.Desc
+	memoryLimitDesc *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*statsCollector)(nil)
+
+func (sc *statsCollector) add(dsi *dockerServerInstance) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.instances[dsi] = struct{}{}
+}
+
+func (sc *statsCollector) remove(dsi *dockerServerInstance) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	delete(sc.instances, dsi)
+}
+
+func (sc *statsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sc.cpuDesc
+	ch <- sc.memoryDesc
+	ch <- sc.memoryLimitDesc
+}
+
+func (sc *statsCollector) Collect(ch chan<- prometheus.Metric) {
+	sc.mu.Lock()
+	instances := make([]*dockerServerInstance, 0, len(sc.instances))
+	for dsi := range sc.instances {
+		instances = append(instances, dsi)
+	}
+	sc.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, dsi := range instances {
+		if dsi.Status() != server.ServerInstanceStatusRunning {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sc.collectInstance(dsi, ch)
+		}()
+	}
+	wg.Wait()
+}
+
+func (sc *statsCollector) collectInstance(dsi *dockerServerInstance, ch chan<- prometheus.Metric) {
+	dsi.mu.RLock()
+	containerID := dsi.containerID
+	id := dsi.options.ID().String()
+	dsi.mu.RUnlock()
+
+	if containerID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(dsi.ctx, statsTimeout)
+	defer cancel()
+
+	reader, err := dsi.client.ContainerStatsOneShot(ctx, containerID)
+	if err != nil {
+		zerolog.Ctx(dsi.ctx).Warn().Msgf("Unable to get container stats: %s", err)
+		return
+	}
+	defer reader.Body.Close()
+
+	var stats container.StatsResponse
+	if err := json.NewDecoder(reader.Body).Decode(&stats); err != nil {
+		zerolog.Ctx(dsi.ctx).Warn().Msgf("Unable to decode container stats: %s", err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(sc.cpuDesc, prometheus.CounterValue, float64(stats.CPUStats.CPUUsage.TotalUsage)/float64(time.Second), id)
+	ch <- prometheus.MustNewConstMetric(sc.memoryDesc, prometheus.GaugeValue, float64(stats.MemoryStats.Usage), id)
+	ch <- prometheus.MustNewConstMetric(sc.memoryLimitDesc, prometheus.GaugeValue, float64(stats.MemoryStats.Limit), id)
+}
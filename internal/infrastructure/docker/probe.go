@@ -0,0 +1,184 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// defaultProbeHost is where TCP and HTTP probes connect to the server's host
+// ports, unless set otherwise with WithProbeHost.
+const defaultProbeHost = "127.0.0.1"
+
+// MARK: startProbe
+
+// startProbe probes the server until it's ready, then marks it as running, or
+// errors it once the probe times out. The probe stops early once ctx is done.
+// It mustn't be called with the lock held, as subscribing to the terminal
+// waits on output being dispatched, which may itself be waiting on the lock.
+func (dsi *dockerServerInstance) startProbe(ctx context.Context, probe server.ReadinessProbe) {
+	// Subscribe straight away so that no output printed while starting is lost.
+	var termOut <-chan string
+	if probe.Type == server.ReadinessProbeTypeConsole {
+		termOut = dsi.events.TerminalOut.On()
+	}
+
+	go func() {
+		if termOut != nil {
+			defer dsi.events.TerminalOut.Off(termOut)
+		}
+
+		err := dsi.runProbe(ctx, probe, termOut)
+		switch {
+		case ctx.Err() != nil:
+		case err != nil:
+			if dsi.swapStatus(server.ServerInstanceStatusStarting, server.ServerInstanceStatusErrored, err.Error()) {
+				zerolog.Ctx(dsi.ctx).Error().Msg(err.Error())
+				dsi.events.TerminalOut.Dispatch(err.Error())
+			}
+		default:
+			if dsi.swapStatus(server.ServerInstanceStatusStarting, server.ServerInstanceStatusRunning, "") {
+				zerolog.Ctx(dsi.ctx).Info().Msg("Readiness probe passed")
+			}
+		}
+	}()
+}
+
+// runProbe returns once the probe passes, or with why it didn't pass in time.
+func (dsi *dockerServerInstance) runProbe(ctx context.Context, probe server.ReadinessProbe, termOut <-chan string) error {
+	interval := probe.Interval
+	if interval <= 0 {
+		interval = server.DefaultReadinessProbeInterval
+	}
+
+	timeout := probe.Timeout
+	if timeout <= 0 {
+		timeout = server.DefaultReadinessProbeTimeout
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	var pattern *regexp.Regexp
+	if probe.Type == server.ReadinessProbeTypeConsole {
+		var err error
+		if pattern, err = regexp.Compile(probe.Pattern); err != nil {
+			return errors.Wrap(err, "Invalid readiness probe pattern")
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastErr := errors.New("never attempted")
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-deadline.C:
+			return errors.Errorf("Readiness probe timed out after %s: %s", timeout, lastErr)
+
+		case line, ok := <-termOut:
+			if !ok {
+				return errors.New("Console closed")
+			}
+
+			if pattern.MatchString(line) {
+				return nil
+			}
+
+		case <-ticker.C:
+			// Console probes only watch the output.
+			if pattern != nil {
+				continue
+			}
+
+			attemptCtx, attemptCancel := context.WithTimeout(ctx, interval)
+			lastErr = dsi.probeOnce(attemptCtx, probe)
+			attemptCancel()
+
+			if lastErr == nil {
+				return nil
+			}
+		}
+	}
+}
+
+// MARK: probeOnce
+
+func (dsi *dockerServerInstance) probeOnce(ctx context.Context, probe server.ReadinessProbe) error {
+	address := net.JoinHostPort(probeHostFromContext(dsi.ctx), strconv.Itoa(probe.Port))
+
+	switch probe.Type {
+	case server.ReadinessProbeTypeTCP:
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+
+	case server.ReadinessProbeTypeHTTP:
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+probe.Path, nil)
+		if err != nil {
+			return err
+		}
+
+		// Redirects count as ready, rather than being followed.
+		client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return errors.Errorf("responded with %s", resp.Status)
+		}
+
+		return nil
+
+	case server.ReadinessProbeTypeExec:
+		process, err := dsi.Exec(ctx, server.ExecOptions{Cmd: probe.Command})
+		if err != nil {
+			return err
+		}
+		defer process.Close()
+
+		// The output is of no interest, but has to be read for it to exit.
+		for {
+			if _, err := process.Next(); err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+
+		exitCode, err := process.Wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		if exitCode != 0 {
+			return errors.Errorf("exited with code %d", exitCode)
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unknown readiness probe type %s", probe.Type)
+	}
+}
@@ -0,0 +1,157 @@
+package docker
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// MARK: - Readiness probes
+
+func testProbeInstance(t *testing.T, probe server.ReadinessProbe) *dockerServerInstance {
+	_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+		InstanceID:     uuid.New(),
+		Image:          "Test",
+		ReadinessProbe: &probe,
+	})
+	dsi.containerID = uuid.Nil.String()
+
+	return dsi
+}
+
+func assertStatusEventually(t *testing.T, dsi *dockerServerInstance, expected server.ServerInstanceStatus) {
+	assert.Eventually(t, func() bool {
+		return dsi.Status() == expected
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReadinessProbe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ok - TCP", func(t *testing.T) {
+		listener, err := net.Listen("tcp", defaultProbeHost+":0")
+		assert.NoError(t, err)
+		defer listener.Close()
+
+		dsi := testProbeInstance(t, server.ReadinessProbe{
+			Type:     server.ReadinessProbeTypeTCP,
+			Port:     listener.Addr().(*net.TCPAddr).Port,
+			Interval: 10 * time.Millisecond,
+		})
+
+		dsi.setStatus(server.ServerInstanceStatusStarting)
+		assertStatusEventually(t, dsi, server.ServerInstanceStatusRunning)
+	})
+
+	t.Run("Ok - HTTP", func(t *testing.T) {
+		ready := false
+		testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !ready || r.URL.Path != "/healthz" {
+				ready = true
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer testServer.Close()
+
+		port, err := strconv.Atoi(testServer.URL[strings.LastIndex(testServer.URL, ":")+1:])
+		assert.NoError(t, err)
+
+		dsi := testProbeInstance(t, server.ReadinessProbe{
+			Type:     server.ReadinessProbeTypeHTTP,
+			Port:     port,
+			Path:     "/healthz",
+			Interval: 10 * time.Millisecond,
+		})
+
+		dsi.setStatus(server.ServerInstanceStatusStarting)
+		assertStatusEventually(t, dsi, server.ServerInstanceStatusRunning)
+	})
+
+	t.Run("Ok - Console", func(t *testing.T) {
+		dsi := testProbeInstance(t, server.ReadinessProbe{
+			Type:    server.ReadinessProbeTypeConsole,
+			Pattern: `Done \(.*\)!`,
+		})
+
+		dsi.setStatus(server.ServerInstanceStatusStarting)
+		dsi.events.TerminalOut.Dispatch("Preparing spawn area: 97%")
+		assert.Equal(t, server.ServerInstanceStatusStarting, dsi.Status())
+
+		dsi.events.TerminalOut.Dispatch(`Done (12.345s)! For help, type "help"`)
+		assertStatusEventually(t, dsi, server.ServerInstanceStatusRunning)
+	})
+
+	t.Run("Ok - Stays starting until the probe passes", func(t *testing.T) {
+		mockClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{
+			InstanceID:     uuid.New(),
+			Image:          "Test",
+			ReadinessProbe: &server.ReadinessProbe{Type: server.ReadinessProbeTypeConsole, Pattern: "Done"},
+		})
+		dsi.containerID = uuid.Nil.String()
+
+		mockClient.EXPECT().ContainerInspect(
+			dsi.ctx,
+			dsi.containerID,
+		).Return(
+			types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					State: &types.ContainerState{Status: "running"},
+				},
+				Mounts:          []types.MountPoint{},
+				Config:          &container.Config{},
+				NetworkSettings: &types.NetworkSettings{},
+			},
+			nil,
+		).Once()
+
+		dsi.setStatus(server.ServerInstanceStatusStarting)
+		dsi.lifecycleActionUpdateStatus()
+		assert.Equal(t, server.ServerInstanceStatusStarting, dsi.Status())
+	})
+
+	t.Run("Err - Times out", func(t *testing.T) {
+		// Find a port nothing is listening on.
+		listener, err := net.Listen("tcp", defaultProbeHost+":0")
+		assert.NoError(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		dsi := testProbeInstance(t, server.ReadinessProbe{
+			Type:     server.ReadinessProbeTypeTCP,
+			Port:     port,
+			Interval: 10 * time.Millisecond,
+			Timeout:  100 * time.Millisecond,
+		})
+
+		dsi.setStatus(server.ServerInstanceStatusStarting)
+		assertStatusEventually(t, dsi, server.ServerInstanceStatusErrored)
+		assert.Contains(t, dsi.StatusReason(), "Readiness probe timed out after 100ms")
+	})
+
+	t.Run("Ok - Stopping abandons the probe", func(t *testing.T) {
+		dsi := testProbeInstance(t, server.ReadinessProbe{
+			Type:    server.ReadinessProbeTypeConsole,
+			Pattern: "Done",
+			Timeout: 50 * time.Millisecond,
+		})
+
+		dsi.setStatus(server.ServerInstanceStatusStarting)
+		dsi.setStatus(server.ServerInstanceStatusStopping)
+
+		// The probe would have timed out by now, had it not been abandoned.
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, server.ServerInstanceStatusStopping, dsi.Status())
+		assert.Nil(t, dsi.probeCancel)
+	})
+}
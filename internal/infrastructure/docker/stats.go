@@ -0,0 +1,110 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/pkg/errors"
+)
+
+// MARK: Stats
+
+func (dsi *dockerServerInstance) Stats(ctx context.Context, follow bool) (server.StatsReader, error) {
+	dsi.mu.RLock()
+	containerID := dsi.containerID
+	status := dsi.status
+	dsi.mu.RUnlock()
+
+	if status != server.ServerInstanceStatusStarting && status != server.ServerInstanceStatusRunning {
+		return nil, &server.InvalidStatusError{Action: "Stats", Status: status}
+	}
+
+	// Unlike a one-shot read, this waits for a second sample so the CPU usage
+	// can be worked out from the difference.
+	stats, err := dsi.client.ContainerStats(ctx, containerID, follow)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read container stats")
+	}
+
+	return &dockerStatsReader{body: stats.Body, decoder: json.NewDecoder(stats.Body)}, nil
+}
+
+// MARK: dockerStatsReader
+
+// dockerStatsReader decodes each sample as docker sends it.
+type dockerStatsReader struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+}
+
+var _ server.StatsReader = (*dockerStatsReader)(nil)
+
+func (sr *dockerStatsReader) Next() (server.Stats, error) {
+	var stats container.StatsResponse
+	if err := sr.decoder.Decode(&stats); err != nil {
+		if err == io.EOF {
+			return server.Stats{}, io.EOF
+		}
+
+		return server.Stats{}, errors.Wrap(err, "Unable to decode container stats")
+	}
+
+	return toStats(&stats), nil
+}
+
+func (sr *dockerStatsReader) Close() error {
+	return sr.body.Close()
+}
+
+// toStats summarizes docker's stats the same way docker stats does.
+func toStats(stats *container.StatsResponse) server.Stats {
+	result := server.Stats{
+		Time:        stats.Read,
+		MemoryLimit: int64(stats.MemoryStats.Limit),
+		PIDs:        int64(stats.PidsStats.Current),
+	}
+
+	// The container's share of the time spent by every core, scaled up so
+	// that each core counts for 100.
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && systemDelta > 0 {
+		result.CPUPercent = cpuDelta / systemDelta * onlineCPUs * 100
+	}
+
+	// cgroup v1 and v2 name the reclaimable page cache differently.
+	memory := stats.MemoryStats.Usage
+	inactive, ok := stats.MemoryStats.Stats["total_inactive_file"]
+	if !ok {
+		inactive = stats.MemoryStats.Stats["inactive_file"]
+	}
+	if inactive < memory {
+		memory -= inactive
+	}
+	result.MemoryUsage = int64(memory)
+
+	for _, network := range stats.Networks {
+		result.NetworkRx += int64(network.RxBytes)
+		result.NetworkTx += int64(network.TxBytes)
+	}
+
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			result.BlockRead += int64(entry.Value)
+		case "write":
+			result.BlockWrite += int64(entry.Value)
+		}
+	}
+
+	return result
+}
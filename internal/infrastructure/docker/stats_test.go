@@ -0,0 +1,96 @@
+package docker
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"oppossome/serverpouch/internal/domain/server"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MARK: - Stats
+
+func TestStats(t *testing.T) {
+	read := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("Ok - Summarizes docker's stats", func(t *testing.T) {
+		mockAPIClient, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{InstanceID: uuid.Nil})
+		dsi.containerID = "test-container"
+		dsi.status = server.ServerInstanceStatusRunning
+
+		stats := container.StatsResponse{
+			Stats: container.Stats{
+				Read: read,
+				CPUStats: container.CPUStats{
+					CPUUsage:    container.CPUUsage{TotalUsage: 3_000},
+					SystemUsage: 10_000,
+					OnlineCPUs:  4,
+				},
+				PreCPUStats: container.CPUStats{
+					CPUUsage:    container.CPUUsage{TotalUsage: 1_000},
+					SystemUsage: 6_000,
+				},
+				MemoryStats: container.MemoryStats{
+					Usage: 1_000,
+					Limit: 4_000,
+					Stats: map[string]uint64{"inactive_file": 250},
+				},
+				BlkioStats: container.BlkioStats{
+					IoServiceBytesRecursive: []container.BlkioStatEntry{
+						{Op: "read", Value: 10},
+						{Op: "write", Value: 20},
+						{Op: "Read", Value: 1},
+					},
+				},
+				PidsStats: container.PidsStats{Current: 12},
+			},
+			Networks: map[string]container.NetworkStats{
+				"eth0": {RxBytes: 100, TxBytes: 200},
+				"eth1": {RxBytes: 1, TxBytes: 2},
+			},
+		}
+		body, err := json.Marshal(stats)
+		assert.NoError(t, err)
+
+		mockAPIClient.EXPECT().ContainerStats(mock.Anything, "test-container", false).
+			Return(container.StatsResponseReader{Body: io.NopCloser(strings.NewReader(string(body)))}, nil)
+
+		reader, err := dsi.Stats(t.Context(), false)
+		assert.NoError(t, err)
+		defer reader.Close()
+
+		sample, err := reader.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, server.Stats{
+			Time:        read,
+			CPUPercent:  200,
+			MemoryUsage: 750,
+			MemoryLimit: 4_000,
+			NetworkRx:   101,
+			NetworkTx:   202,
+			BlockRead:   11,
+			BlockWrite:  20,
+			PIDs:        12,
+		}, sample)
+
+		_, err = reader.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Err - Not running", func(t *testing.T) {
+		_, dsi := testDockerServerInstance(t, &DockerServerInstanceOptions{InstanceID: uuid.Nil})
+		dsi.containerID = "test-container"
+		dsi.status = server.ServerInstanceStatusIdle
+
+		var statusErr *server.InvalidStatusError
+		_, err := dsi.Stats(t.Context(), true)
+		assert.ErrorAs(t, err, &statusErr)
+	})
+}
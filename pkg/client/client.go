@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// WithToken authenticates every request with the API token.
+func WithToken(token string) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// Error implements error so problems can be returned as is.
+func (e *Error) Error() string {
+	msg := e.Title
+	if e.Detail != nil {
+		msg = fmt.Sprintf("%s: %s", msg, *e.Detail)
+	}
+
+	if e.Errors != nil {
+		fields := make([]string, len(*e.Errors))
+		for idx, fieldErr := range *e.Errors {
+			fields[idx] = fmt.Sprintf("%s %s", fieldErr.Field, fieldErr.Message)
+		}
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(fields, "; "))
+	}
+
+	return msg
+}
+
+// CheckResponse returns the problem described by a failed response, or nil if
+// it succeeded.
+func CheckResponse(resp *http.Response, body []byte) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	problem := &Error{}
+	if err := json.Unmarshal(body, problem); err != nil || problem.Title == "" {
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	return problem
+}